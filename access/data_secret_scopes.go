@@ -0,0 +1,73 @@
+package access
+
+import (
+	"context"
+	"sort"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// SecretScopeSummary is a secret scope together with its backend metadata
+type SecretScopeSummary struct {
+	Name             string            `json:"name,omitempty"`
+	BackendType      string            `json:"backend_type,omitempty"`
+	KeyvaultMetadata *KeyvaultMetadata `json:"keyvault_metadata,omitempty"`
+}
+
+// DataSourceSecretScopes lists secret scopes with their backend metadata
+func DataSourceSecretScopes() *schema.Resource {
+	type secretScopes struct {
+		BackendType string               `json:"backend_type,omitempty"`
+		Names       []string             `json:"names,omitempty" tf:"computed,slice_set"`
+		Scopes      []SecretScopeSummary `json:"scopes,omitempty" tf:"computed"`
+	}
+	s := common.StructToSchema(secretScopes{}, func(
+		s map[string]*schema.Schema) map[string]*schema.Schema {
+		// nolint once SDKv2 has Diagnostics-returning validators, change
+		s["backend_type"].ValidateFunc = validation.StringInSlice([]string{
+			"DATABRICKS", "AZURE_KEYVAULT"}, false)
+		return s
+	})
+	return &schema.Resource{
+		Schema: s,
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			var this secretScopes
+			err := common.DataToStructPointer(d, s, &this)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			scopes, err := NewSecretScopesAPI(ctx, m).List()
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			sort.Slice(scopes, func(i, j int) bool {
+				return scopes[i].Name < scopes[j].Name
+			})
+			this.Names = []string{}
+			this.Scopes = []SecretScopeSummary{}
+			for _, scope := range scopes {
+				if this.BackendType != "" && scope.BackendType != this.BackendType {
+					continue
+				}
+				this.Names = append(this.Names, scope.Name)
+				this.Scopes = append(this.Scopes, SecretScopeSummary{
+					Name:             scope.Name,
+					BackendType:      scope.BackendType,
+					KeyvaultMetadata: scope.KeyvaultMetadata,
+				})
+			}
+			d.SetId("_")
+			if this.BackendType != "" {
+				d.SetId(this.BackendType)
+			}
+			err = common.StructToData(this, s, d)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,62 @@
+package access
+
+import (
+	"testing"
+
+	"github.com/databrickslabs/terraform-provider-databricks/qa"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func secretScopesFixture() []qa.HTTPFixture {
+	return []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/secrets/scopes/list",
+			Response: SecretScopeList{
+				Scopes: []SecretScope{
+					{
+						Name:        "kv",
+						BackendType: "AZURE_KEYVAULT",
+						KeyvaultMetadata: &KeyvaultMetadata{
+							ResourceID: "/subscriptions/123/resourceGroups/rg/providers/Microsoft.KeyVault/vaults/my-kv",
+							DNSName:    "https://my-kv.vault.azure.net/",
+						},
+					},
+					{
+						Name:        "app",
+						BackendType: "DATABRICKS",
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestDataSourceSecretScopes(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures:    secretScopesFixture(),
+		Resource:    DataSourceSecretScopes(),
+		Read:        true,
+		NonWritable: true,
+		ID:          ".",
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, 2, d.Get("names").(*schema.Set).Len())
+	assert.Equal(t, "app", d.Get("scopes.0.name"))
+	assert.Equal(t, "https://my-kv.vault.azure.net/", d.Get("scopes.1.keyvault_metadata.0.dns_name"))
+}
+
+func TestDataSourceSecretScopes_BackendType(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures:    secretScopesFixture(),
+		Resource:    DataSourceSecretScopes(),
+		Read:        true,
+		NonWritable: true,
+		ID:          ".",
+		HCL:         `backend_type = "AZURE_KEYVAULT"`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, []interface{}{"kv"}, d.Get("names").(*schema.Set).List())
+	assert.Equal(t, "AZURE_KEYVAULT", d.Get("scopes.0.backend_type"))
+}
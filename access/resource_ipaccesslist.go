@@ -2,6 +2,12 @@ package access
 
 import (
 	"context"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strings"
+	"time"
 
 	"github.com/databrickslabs/terraform-provider-databricks/common"
 
@@ -87,10 +93,172 @@ func (a ipAccessListsAPI) Read(objectID string) (status ipAccessListStatus, err
 
 func (a ipAccessListsAPI) List() (listResponse listIPAccessListsResponse, err error) {
 	listResponse = listIPAccessListsResponse{}
-	err = a.client.Get(a.context, "/ip-access-lists", &listResponse, nil)
+	err = a.client.Get(a.context, "/ip-access-lists", nil, &listResponse)
 	return
 }
 
+// parseIPRange parses IPv4 address or CIDR block, which are the only ones supported by IP access lists
+func parseIPRange(value string) (*net.IPNet, error) {
+	if !strings.Contains(value, "/") {
+		ip := net.ParseIP(value).To4()
+		if ip == nil {
+			return nil, fmt.Errorf("%s is not a valid IPv4 address", value)
+		}
+		return &net.IPNet{IP: ip, Mask: net.CIDRMask(32, 32)}, nil
+	}
+	ip, network, err := net.ParseCIDR(value)
+	if err != nil || ip.To4() == nil {
+		return nil, fmt.Errorf("%s is not a valid IPv4 CIDR block", value)
+	}
+	return network, nil
+}
+
+func validateIPRange(i interface{}, k string) (warnings []string, errors []error) {
+	value := i.(string)
+	network, err := parseIPRange(value)
+	if err != nil {
+		return nil, []error{err}
+	}
+	if strings.Contains(value, "/") && network.String() != value {
+		warnings = append(warnings, fmt.Sprintf("%s has host bits set and is treated as %s",
+			value, network))
+	}
+	return
+}
+
+func rangesOverlap(a, b *net.IPNet) bool {
+	return a.Contains(b.IP) || b.Contains(a.IP)
+}
+
+// findOverlaps returns descriptions of overlapping pairs of ranges from two lists.
+// If sameList is set, a and b are the same list and every pair is checked only once.
+func findOverlaps(a, b []string, sameList bool) (overlaps []string) {
+	for i, x := range a {
+		xn, err := parseIPRange(x)
+		if err != nil {
+			continue
+		}
+		for j, y := range b {
+			if sameList && j <= i {
+				continue
+			}
+			yn, err := parseIPRange(y)
+			if err != nil {
+				continue
+			}
+			if rangesOverlap(xn, yn) {
+				overlaps = append(overlaps, fmt.Sprintf("%s overlaps with %s", x, y))
+			}
+		}
+	}
+	return
+}
+
+// findOverlapsWithOtherLists returns an error, if the list overlaps with other lists of the same type
+func findOverlapsWithOtherLists(list ipAccessListStatus, others []ipAccessListStatus) error {
+	for _, other := range others {
+		if other.ListID == list.ListID && list.ListID != "" {
+			continue
+		}
+		if other.ListType != list.ListType {
+			continue
+		}
+		overlaps := findOverlaps(list.IPAddresses, other.IPAddresses, false)
+		if len(overlaps) > 0 {
+			return fmt.Errorf("%s in %s list", strings.Join(overlaps, ", "), other.Label)
+		}
+	}
+	return nil
+}
+
+// ipDenied applies IP access list semantics: address is denied, if it's in any of enabled BLOCK lists,
+// or if there are enabled ALLOW lists and address is in none of them
+func ipDenied(ip net.IP, lists []ipAccessListStatus) (bool, string) {
+	hasAllowLists, allowed := false, false
+	for _, list := range lists {
+		if !list.Enabled {
+			continue
+		}
+		if list.ListType == "ALLOW" {
+			hasAllowLists = true
+		}
+		for _, value := range list.IPAddresses {
+			network, err := parseIPRange(value)
+			if err != nil || !network.Contains(ip) {
+				continue
+			}
+			if list.ListType == "BLOCK" {
+				return true, fmt.Sprintf("it's in %s of %s block list", value, list.Label)
+			}
+			allowed = true
+		}
+	}
+	if hasAllowLists && !allowed {
+		return true, "it's not in any of allow lists"
+	}
+	return false, ""
+}
+
+// clientIP discovers public IP address, from which requests to the workspace most likely originate.
+// Request goes through the transport of the provider, so that proxy settings are respected.
+var clientIP = func(ctx context.Context, c *common.DatabricksClient) (net.IP, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://checkip.amazonaws.com", nil)
+	if err != nil {
+		return nil, err
+	}
+	client := &http.Client{
+		Transport: c.Transport(),
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	ip := net.ParseIP(strings.TrimSpace(string(body)))
+	if ip == nil {
+		return nil, fmt.Errorf("unexpected response: %s", body)
+	}
+	return ip, nil
+}
+
+// checkIPAccessList refuses to write the list, if it overlaps with other lists of the same type,
+// or, unless force is set, if it would block the address of the caller
+func (a ipAccessListsAPI) checkIPAccessList(list ipAccessListStatus, force bool) error {
+	existing, err := a.List()
+	if err != nil {
+		return err
+	}
+	if err = findOverlapsWithOtherLists(list, existing.ListIPAccessListsResponse); err != nil {
+		return err
+	}
+	if force || !list.Enabled {
+		return nil
+	}
+	lists := []ipAccessListStatus{list}
+	for _, other := range existing.ListIPAccessListsResponse {
+		if other.ListID == list.ListID && list.ListID != "" {
+			continue
+		}
+		lists = append(lists, other)
+	}
+	ip, err := clientIP(a.context, a.client)
+	if err != nil {
+		return fmt.Errorf("cannot determine client IP: %w. "+
+			"Set force = true to skip the check", err)
+	}
+	if denied, reason := ipDenied(ip, lists); denied {
+		return fmt.Errorf("refusing to apply %s list, because it would block current client IP %s: %s. "+
+			"Set force = true to apply it anyway", list.Label, ip, reason)
+	}
+	return nil
+}
+
 // ResourceIPAccessList manages IP access lists
 func ResourceIPAccessList() *schema.Resource {
 	s := common.StructToSchema(ipAccessListUpdateRequest{}, func(s map[string]*schema.Schema) map[string]*schema.Schema {
@@ -98,19 +266,59 @@ func ResourceIPAccessList() *schema.Resource {
 		s["list_type"].ValidateFunc = validation.StringInSlice([]string{"ALLOW", "BLOCK"}, false)
 		s["ip_addresses"].Elem = &schema.Schema{
 			Type:         schema.TypeString,
-			ValidateFunc: validation.Any(validation.IsIPv4Address, validation.IsCIDR),
+			ValidateFunc: validateIPRange,
 		}
 		s["enabled"].Default = true
+		s["force"] = &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		}
 		return s
 	})
 	return common.Resource{
 		Schema: s,
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, c interface{}) error {
+			var addresses []string
+			for _, v := range d.Get("ip_addresses").([]interface{}) {
+				if s, ok := v.(string); ok {
+					addresses = append(addresses, s)
+				}
+			}
+			if overlaps := findOverlaps(addresses, addresses, true); len(overlaps) > 0 {
+				return fmt.Errorf("%s", strings.Join(overlaps, ", "))
+			}
+			if (!d.HasChange("list_type") && !d.HasChange("ip_addresses")) ||
+				!d.NewValueKnown("list_type") || !d.NewValueKnown("ip_addresses") {
+				return nil
+			}
+			// lists of the same type must not overlap across the whole workspace
+			existing, err := NewIPAccessListsAPI(ctx, c).List()
+			if err != nil {
+				return err
+			}
+			return findOverlapsWithOtherLists(ipAccessListStatus{
+				ListID:      d.Id(),
+				ListType:    d.Get("list_type").(string),
+				IPAddresses: addresses,
+			}, existing.ListIPAccessListsResponse)
+		},
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			var iacl createIPAccessListRequest
 			if err := common.DataToStructPointer(d, s, &iacl); err != nil {
 				return err
 			}
-			status, err := NewIPAccessListsAPI(ctx, c).Create(iacl)
+			ipAccessListsAPI := NewIPAccessListsAPI(ctx, c)
+			err := ipAccessListsAPI.checkIPAccessList(ipAccessListStatus{
+				Label:       iacl.Label,
+				ListType:    iacl.ListType,
+				IPAddresses: iacl.IPAddresses,
+				Enabled:     d.Get("enabled").(bool),
+			}, d.Get("force").(bool))
+			if err != nil {
+				return err
+			}
+			status, err := ipAccessListsAPI.Create(iacl)
 			if err != nil {
 				return err
 			}
@@ -129,7 +337,18 @@ func ResourceIPAccessList() *schema.Resource {
 			if err := common.DataToStructPointer(d, s, &iacl); err != nil {
 				return err
 			}
-			return NewIPAccessListsAPI(ctx, c).Update(d.Id(), iacl)
+			ipAccessListsAPI := NewIPAccessListsAPI(ctx, c)
+			err := ipAccessListsAPI.checkIPAccessList(ipAccessListStatus{
+				ListID:      d.Id(),
+				Label:       iacl.Label,
+				ListType:    iacl.ListType,
+				IPAddresses: iacl.IPAddresses,
+				Enabled:     iacl.Enabled,
+			}, d.Get("force").(bool))
+			if err != nil {
+				return err
+			}
+			return ipAccessListsAPI.Update(d.Id(), iacl)
 		},
 		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			return NewIPAccessListsAPI(ctx, c).Delete(d.Id())
@@ -4,6 +4,8 @@ package access
 
 import (
 	"context"
+	"fmt"
+	"net"
 	"net/http"
 	"os"
 	"testing"
@@ -23,8 +25,22 @@ var (
 	TestingEnabled          = true
 	TestingIPAddresses      = []string{"1.2.3.4", "1.2.4.0/24"}
 	TestingIPAddressesState = []interface{}{"1.2.3.4", "1.2.4.0/24"}
+
+	noOtherIPAccessLists = qa.HTTPFixture{
+		Method:       http.MethodGet,
+		Resource:     "/api/2.0/ip-access-lists",
+		ReuseRequest: true,
+		Response:     listIPAccessListsResponse{},
+	}
 )
 
+func init() {
+	// keep unit tests hermetic and pretend to always come from the same address
+	clientIP = func(ctx context.Context, c *common.DatabricksClient) (net.IP, error) {
+		return net.ParseIP("10.0.0.1"), nil
+	}
+}
+
 func TestAccIPACL(t *testing.T) {
 	cloud := os.Getenv("CLOUD_ENV")
 	if cloud == "" {
@@ -58,6 +74,7 @@ func TestAccIPACL(t *testing.T) {
 func TestIPACLCreate(t *testing.T) {
 	d, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
+			noOtherIPAccessLists,
 			{
 				Method:   http.MethodPost,
 				Resource: "/api/2.0/ip-access-lists",
@@ -119,6 +136,7 @@ func TestIPACLCreate(t *testing.T) {
 func TestAPIACLCreate_Error(t *testing.T) {
 	d, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
+			noOtherIPAccessLists,
 			{
 				Method:   http.MethodPost,
 				Resource: "/api/2.0/ip-access-lists",
@@ -145,6 +163,7 @@ func TestAPIACLCreate_Error(t *testing.T) {
 func TestIPACLUpdate(t *testing.T) {
 	d, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
+			noOtherIPAccessLists,
 			{
 				Method:   http.MethodGet,
 				Resource: "/api/2.0/ip-access-lists/" + TestingID,
@@ -202,6 +221,7 @@ func TestIPACLUpdate(t *testing.T) {
 func TestIPACLUpdate_Error(t *testing.T) {
 	_, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
+			noOtherIPAccessLists,
 			{
 				Method:   http.MethodPut,
 				Resource: "/api/2.0/ip-access-lists/" + TestingID,
@@ -219,6 +239,214 @@ func TestIPACLUpdate_Error(t *testing.T) {
 	qa.AssertErrorStartsWith(t, err, "Something unexpected")
 }
 
+func TestParseIPRange(t *testing.T) {
+	n, err := parseIPRange("1.2.3.4")
+	require.NoError(t, err)
+	assert.Equal(t, "1.2.3.4/32", n.String())
+
+	n, err = parseIPRange("10.0.0.0/8")
+	require.NoError(t, err)
+	assert.Equal(t, "10.0.0.0/8", n.String())
+
+	_, err = parseIPRange("1.2.3")
+	assert.EqualError(t, err, "1.2.3 is not a valid IPv4 address")
+
+	_, err = parseIPRange("::1/128")
+	assert.EqualError(t, err, "::1/128 is not a valid IPv4 CIDR block")
+
+	warnings, errs := validateIPRange("10.1.2.3/8", "ip_addresses.0")
+	assert.Len(t, errs, 0)
+	assert.Equal(t, []string{"10.1.2.3/8 has host bits set and is treated as 10.0.0.0/8"}, warnings)
+}
+
+func TestFindOverlaps(t *testing.T) {
+	assert.Equal(t, []string{"1.2.3.4 overlaps with 1.2.3.0/24"},
+		findOverlaps([]string{"1.2.3.4", "5.6.7.8", "1.2.3.0/24"},
+			[]string{"1.2.3.4", "5.6.7.8", "1.2.3.0/24"}, true))
+	assert.Equal(t, []string{"10.0.0.0/8 overlaps with 10.1.0.0/16"},
+		findOverlaps([]string{"10.0.0.0/8"}, []string{"10.1.0.0/16", "11.0.0.1"}, false))
+	assert.Len(t, findOverlaps([]string{"1.1.1.1"}, []string{"1.1.1.2"}, false), 0)
+	// different lists with the same ranges
+	assert.Equal(t, []string{"1.1.1.1 overlaps with 1.1.1.1"},
+		findOverlaps([]string{"1.1.1.1"}, []string{"1.1.1.1"}, false))
+}
+
+func TestIPDenied(t *testing.T) {
+	ip := net.ParseIP("10.0.0.1")
+	denied, _ := ipDenied(ip, []ipAccessListStatus{
+		{Label: "office", ListType: "ALLOW", IPAddresses: []string{"10.0.0.0/24"}, Enabled: true},
+	})
+	assert.False(t, denied)
+
+	denied, reason := ipDenied(ip, []ipAccessListStatus{
+		{Label: "office", ListType: "ALLOW", IPAddresses: []string{"10.0.1.0/24"}, Enabled: true},
+		{Label: "vpn", ListType: "ALLOW", IPAddresses: []string{"10.0.0.0/24"}, Enabled: false},
+	})
+	assert.True(t, denied)
+	assert.Equal(t, "it's not in any of allow lists", reason)
+
+	denied, reason = ipDenied(ip, []ipAccessListStatus{
+		{Label: "office", ListType: "ALLOW", IPAddresses: []string{"10.0.0.0/24"}, Enabled: true},
+		{Label: "bad", ListType: "BLOCK", IPAddresses: []string{"10.0.0.1"}, Enabled: true},
+	})
+	assert.True(t, denied)
+	assert.Equal(t, "it's in 10.0.0.1 of bad block list", reason)
+}
+
+func TestIPACLCreate_OverlapsWithinList(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceIPAccessList(),
+		Create:   true,
+		HCL: `label = "office"
+		list_type = "ALLOW"
+		ip_addresses = ["10.0.0.0/16", "10.0.1.1"]`,
+	}.ExpectError(t, "10.0.0.0/16 overlaps with 10.0.1.1")
+}
+
+func TestIPACLCreate_OverlapsWithOtherList(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/ip-access-lists",
+				Response: listIPAccessListsResponse{
+					ListIPAccessListsResponse: []ipAccessListStatus{
+						{
+							ListID:      "other",
+							Label:       "vpn",
+							ListType:    "ALLOW",
+							IPAddresses: []string{"10.0.0.0/8"},
+							Enabled:     true,
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceIPAccessList(),
+		Create:   true,
+		HCL: `label = "office"
+		list_type = "ALLOW"
+		ip_addresses = ["10.0.1.0/24"]`,
+	}.ExpectError(t, "10.0.1.0/24 overlaps with 10.0.0.0/8 in vpn list")
+}
+
+func TestIPACLCreate_SelfLockout(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			noOtherIPAccessLists,
+		},
+		Resource: ResourceIPAccessList(),
+		Create:   true,
+		HCL: `label = "office"
+		list_type = "ALLOW"
+		ip_addresses = ["1.2.3.0/24"]`,
+	}.ExpectError(t, "refusing to apply office list, because it would block current client IP "+
+		"10.0.0.1: it's not in any of allow lists. Set force = true to apply it anyway")
+}
+
+func TestIPACLCreate_Force(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			noOtherIPAccessLists,
+			{
+				Method:   http.MethodPost,
+				Resource: "/api/2.0/ip-access-lists",
+				ExpectedRequest: createIPAccessListRequest{
+					Label:       "office",
+					ListType:    "ALLOW",
+					IPAddresses: []string{"1.2.3.0/24"},
+				},
+				Response: ipAccessListStatusWrapper{
+					IPAccessList: ipAccessListStatus{
+						ListID: TestingID,
+					},
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/ip-access-lists/" + TestingID,
+				Response: ipAccessListStatusWrapper{
+					IPAccessList: ipAccessListStatus{
+						ListID:      TestingID,
+						Label:       "office",
+						ListType:    "ALLOW",
+						IPAddresses: []string{"1.2.3.0/24"},
+						Enabled:     true,
+					},
+				},
+			},
+		},
+		Resource: ResourceIPAccessList(),
+		Create:   true,
+		HCL: `label = "office"
+		list_type = "ALLOW"
+		ip_addresses = ["1.2.3.0/24"]
+		force = true`,
+	}.ApplyNoError(t)
+}
+
+func TestIPACLUpdate_ClientIPUnknown(t *testing.T) {
+	defer func(original func(context.Context, *common.DatabricksClient) (net.IP, error)) {
+		clientIP = original
+	}(clientIP)
+	clientIP = func(ctx context.Context, c *common.DatabricksClient) (net.IP, error) {
+		return nil, fmt.Errorf("offline")
+	}
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			noOtherIPAccessLists,
+		},
+		Resource: ResourceIPAccessList(),
+		Update:   true,
+		ID:       TestingID,
+		InstanceState: map[string]string{
+			"label":          "office",
+			"list_type":      "ALLOW",
+			"ip_addresses.#": "1",
+			"ip_addresses.0": "1.2.4.0/24",
+			"enabled":        "true",
+		},
+		HCL: `label = "office"
+		list_type = "ALLOW"
+		ip_addresses = ["1.2.3.0/24"]`,
+	}.ExpectError(t, "cannot determine client IP: offline. Set force = true to skip the check")
+}
+
+func TestIPACLUpdate_OverlapsWithOtherListOnPlan(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/ip-access-lists",
+				Response: listIPAccessListsResponse{
+					ListIPAccessListsResponse: []ipAccessListStatus{
+						{
+							ListID:      "other",
+							Label:       "vpn",
+							ListType:    "BLOCK",
+							IPAddresses: []string{"1.2.3.4"},
+							Enabled:     true,
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceIPAccessList(),
+		Update:   true,
+		ID:       TestingID,
+		InstanceState: map[string]string{
+			"label":          "office",
+			"list_type":      "ALLOW",
+			"ip_addresses.#": "1",
+			"ip_addresses.0": "1.2.3.4",
+			"enabled":        "true",
+		},
+		HCL: `label = "office"
+		list_type = "BLOCK"
+		ip_addresses = ["1.2.3.4"]`,
+	}.ExpectError(t, "1.2.3.4 overlaps with 1.2.3.4 in vpn list")
+}
+
 func TestIPACLRead(t *testing.T) {
 	d, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"regexp"
+	"strings"
 
 	"github.com/databrickslabs/terraform-provider-databricks/common"
 
@@ -66,14 +67,18 @@ func (a SecretScopesAPI) Create(s SecretScope) error {
 			//lint:ignore ST1005 Azure is a valid capitalized string
 			return fmt.Errorf("Azure KeyVault is not available")
 		}
-		if a.client.IsAzureClientSecretSet() {
-			//lint:ignore ST1005 Azure is a valid capitalized string
-			return fmt.Errorf("Azure KeyVault cannot yet be configured for Service Principal authorization")
+		if err := keyvaultAuthError(a.client); err != nil {
+			return err
 		}
 		req.BackendType = "AZURE_KEYVAULT"
 		req.BackendAzureKeyvault = s.KeyvaultMetadata
 	}
-	return a.client.Post(a.context, "/secrets/scopes/create", req, nil)
+	err := a.client.Post(a.context, "/secrets/scopes/create", req, nil)
+	if err != nil && strings.Contains(err.Error(), "userAADToken") {
+		return fmt.Errorf("%w. Azure KeyVault-based secret scopes can only be created "+
+			"with Azure AD token of a user, e.g. via Azure CLI authentication", err)
+	}
+	return err
 }
 
 // Delete deletes a secret scope
@@ -114,19 +119,71 @@ var validScope = validation.StringMatch(regexp.MustCompile(`^[\w\.@_/-]{1,128}$`
 	"Must consist of alphanumeric characters, dashes, underscores, and periods, "+
 		"and may not exceed 128 characters.")
 
+var (
+	keyvaultResourceID = regexp.MustCompile(
+		`(?i)^/subscriptions/[^/]+/resourceGroups/[^/]+/providers/Microsoft\.KeyVault/vaults/([^/]+)$`)
+	keyvaultDNSName = regexp.MustCompile(
+		`(?i)^https://([^./]+)\.vault\.(azure\.net|azure\.cn|usgovcloudapi\.net|microsoftazure\.de)/?$`)
+)
+
+// validateKeyvaultMetadata checks that resource ID and DNS name point to the same Azure Key Vault
+func validateKeyvaultMetadata(kv KeyvaultMetadata) error {
+	id := keyvaultResourceID.FindStringSubmatch(kv.ResourceID)
+	if id == nil {
+		return fmt.Errorf("invalid keyvault_metadata.resource_id: %s. Expected format is "+
+			"/subscriptions/<subscription>/resourceGroups/<group>/providers/Microsoft.KeyVault/vaults/<name>",
+			kv.ResourceID)
+	}
+	dns := keyvaultDNSName.FindStringSubmatch(kv.DNSName)
+	if dns == nil {
+		return fmt.Errorf("invalid keyvault_metadata.dns_name: %s. Expected format is "+
+			"https://<name>.vault.azure.net/", kv.DNSName)
+	}
+	if !strings.EqualFold(id[1], dns[1]) {
+		return fmt.Errorf("keyvault_metadata.dns_name points to %s vault, but resource_id points to %s vault",
+			dns[1], id[1])
+	}
+	return nil
+}
+
+// keyvaultAuthError explains, why Azure KeyVault-based scopes cannot be created with the configured authentication
+func keyvaultAuthError(client *common.DatabricksClient) error {
+	if !client.IsAzure() {
+		return nil
+	}
+	if client.IsAzureClientSecretSet() {
+		return fmt.Errorf("you can't set up Azure KeyVault-based secret scope via Service Principal, " +
+			"because it requires Azure AD token of a user. Please use Azure CLI authentication instead")
+	}
+	if client.AzureUseMSI {
+		return fmt.Errorf("you can't set up Azure KeyVault-based secret scope via Managed Identity, " +
+			"because it requires Azure AD token of a user. Please use Azure CLI authentication instead")
+	}
+	return nil
+}
+
 func kvDiffFunc(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
 	if diff == nil {
 		return nil
 	}
 	kvLst := diff.Get("keyvault_metadata").([]interface{})
-	if len(kvLst) == 0 {
+	if len(kvLst) == 0 || kvLst[0] == nil {
 		return nil
 	}
-	client := v.(*common.DatabricksClient)
-	if client.IsAzure() && client.IsAzureClientSecretSet() {
-		return fmt.Errorf("you can't set up Azure KeyVault-based secret scope via Service Principal")
+	if !diff.NewValueKnown("keyvault_metadata.0.resource_id") ||
+		!diff.NewValueKnown("keyvault_metadata.0.dns_name") {
+		// values are known only after Azure Key Vault is created
+		return keyvaultAuthError(v.(*common.DatabricksClient))
 	}
-	return nil
+	kv := kvLst[0].(map[string]interface{})
+	err := validateKeyvaultMetadata(KeyvaultMetadata{
+		ResourceID: kv["resource_id"].(string),
+		DNSName:    kv["dns_name"].(string),
+	})
+	if err != nil {
+		return err
+	}
+	return keyvaultAuthError(v.(*common.DatabricksClient))
 }
 
 // ResourceSecretScope manages secret scopes
@@ -53,8 +53,8 @@ func TestResourceSecretScopeRead_KeyVault(t *testing.T) {
 							Name:        "abc",
 							BackendType: "AZURE_KEYVAULT",
 							KeyvaultMetadata: &KeyvaultMetadata{
-								ResourceID: "bcd",
-								DNSName:    "def",
+								ResourceID: "/subscriptions/123/resourceGroups/rg/providers/Microsoft.KeyVault/vaults/my-kv",
+								DNSName:    "https://my-kv.vault.azure.net/",
 							},
 						},
 					},
@@ -165,8 +165,8 @@ func TestResourceSecretScopeCreate_KeyVault(t *testing.T) {
 					Scope:       "Boom",
 					BackendType: "AZURE_KEYVAULT",
 					BackendAzureKeyvault: &KeyvaultMetadata{
-						ResourceID: "bcd",
-						DNSName:    "def",
+						ResourceID: "/subscriptions/123/resourceGroups/rg/providers/Microsoft.KeyVault/vaults/my-kv",
+						DNSName:    "https://my-kv.vault.azure.net/",
 					},
 				},
 			},
@@ -179,8 +179,8 @@ func TestResourceSecretScopeCreate_KeyVault(t *testing.T) {
 							Name:        "Boom",
 							BackendType: "AZURE_KEYVAULT",
 							KeyvaultMetadata: &KeyvaultMetadata{
-								ResourceID: "bcd",
-								DNSName:    "def",
+								ResourceID: "/subscriptions/123/resourceGroups/rg/providers/Microsoft.KeyVault/vaults/my-kv",
+								DNSName:    "https://my-kv.vault.azure.net/",
 							},
 						},
 					},
@@ -192,8 +192,8 @@ func TestResourceSecretScopeCreate_KeyVault(t *testing.T) {
 		HCL: `
 		name = "Boom"
 		keyvault_metadata {
-			resource_id = "bcd"
-			dns_name = "def"
+			resource_id = "/subscriptions/123/resourceGroups/rg/providers/Microsoft.KeyVault/vaults/my-kv"
+			dns_name = "https://my-kv.vault.azure.net/"
 		}`,
 		Azure:  true,
 		Create: true,
@@ -318,8 +318,8 @@ func TestKVDiffFuncSPN(t *testing.T) {
 					Scope:       "Boom",
 					BackendType: "AZURE_KEYVAULT",
 					BackendAzureKeyvault: &KeyvaultMetadata{
-						ResourceID: "bcd",
-						DNSName:    "def",
+						ResourceID: "/subscriptions/123/resourceGroups/rg/providers/Microsoft.KeyVault/vaults/my-kv",
+						DNSName:    "https://my-kv.vault.azure.net/",
 					},
 				},
 			},
@@ -328,11 +328,91 @@ func TestKVDiffFuncSPN(t *testing.T) {
 		HCL: `
 			name = "Boom"
 			keyvault_metadata {
-				resource_id = "bcd"
-				dns_name = "def"
+				resource_id = "/subscriptions/123/resourceGroups/rg/providers/Microsoft.KeyVault/vaults/my-kv"
+				dns_name = "https://my-kv.vault.azure.net/"
 			}`,
 		Azure:    true,
 		AzureSPN: true,
 		Create:   true,
-	}.ExpectError(t, "you can't set up Azure KeyVault-based secret scope via Service Principal")
+	}.ExpectError(t, "you can't set up Azure KeyVault-based secret scope via Service Principal, "+
+		"because it requires Azure AD token of a user. Please use Azure CLI authentication instead")
+}
+
+func TestValidateKeyvaultMetadata(t *testing.T) {
+	id := "/subscriptions/123/resourceGroups/rg/providers/Microsoft.KeyVault/vaults/my-kv"
+	assert.NoError(t, validateKeyvaultMetadata(KeyvaultMetadata{
+		ResourceID: id,
+		DNSName:    "https://My-KV.vault.azure.net/",
+	}))
+	assert.NoError(t, validateKeyvaultMetadata(KeyvaultMetadata{
+		ResourceID: id,
+		DNSName:    "https://my-kv.vault.azure.cn",
+	}))
+	assert.EqualError(t, validateKeyvaultMetadata(KeyvaultMetadata{
+		ResourceID: "my-kv",
+		DNSName:    "https://my-kv.vault.azure.net/",
+	}), "invalid keyvault_metadata.resource_id: my-kv. Expected format is "+
+		"/subscriptions/<subscription>/resourceGroups/<group>/providers/Microsoft.KeyVault/vaults/<name>")
+	assert.EqualError(t, validateKeyvaultMetadata(KeyvaultMetadata{
+		ResourceID: id,
+		DNSName:    "my-kv.vault.azure.net",
+	}), "invalid keyvault_metadata.dns_name: my-kv.vault.azure.net. Expected format is "+
+		"https://<name>.vault.azure.net/")
+	assert.EqualError(t, validateKeyvaultMetadata(KeyvaultMetadata{
+		ResourceID: id,
+		DNSName:    "https://other-kv.vault.azure.net/",
+	}), "keyvault_metadata.dns_name points to other-kv vault, but resource_id points to my-kv vault")
+}
+
+func TestKVDiffFuncMismatch(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceSecretScope(),
+		HCL: `
+			name = "Boom"
+			keyvault_metadata {
+				resource_id = "/subscriptions/123/resourceGroups/rg/providers/Microsoft.KeyVault/vaults/my-kv"
+				dns_name = "https://other-kv.vault.azure.net/"
+			}`,
+		Azure:  true,
+		Create: true,
+	}.ExpectError(t, "keyvault_metadata.dns_name points to other-kv vault, but resource_id points to my-kv vault")
+}
+
+func TestKVDiffFuncMSI(t *testing.T) {
+	err := keyvaultAuthError(&common.DatabricksClient{
+		Host:        "https://adb-123.4.azuredatabricks.net",
+		AzureUseMSI: true,
+	})
+	assert.EqualError(t, err, "you can't set up Azure KeyVault-based secret scope via Managed Identity, "+
+		"because it requires Azure AD token of a user. Please use Azure CLI authentication instead")
+	assert.NoError(t, keyvaultAuthError(&common.DatabricksClient{
+		Host: "https://dbc-123.cloud.databricks.com",
+	}))
+}
+
+func TestResourceSecretScopeCreate_KeyVaultUserTokenError(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/secrets/scopes/create",
+				Status:   400,
+				Response: common.APIErrorBody{
+					ErrorCode: "INVALID_PARAMETER_VALUE",
+					Message:   "Scope with Azure KeyVault must have userAADToken defined!",
+				},
+			},
+		},
+		Resource: ResourceSecretScope(),
+		HCL: `
+			name = "Boom"
+			keyvault_metadata {
+				resource_id = "/subscriptions/123/resourceGroups/rg/providers/Microsoft.KeyVault/vaults/my-kv"
+				dns_name = "https://my-kv.vault.azure.net/"
+			}`,
+		Azure:  true,
+		Create: true,
+	}.ExpectError(t, "Scope with Azure KeyVault must have userAADToken defined!. "+
+		"Azure KeyVault-based secret scopes can only be created with Azure AD token of a user, "+
+		"e.g. via Azure CLI authentication")
 }
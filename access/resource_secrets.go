@@ -0,0 +1,314 @@
+package access
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"gopkg.in/yaml.v3"
+)
+
+var validSecretKey = regexp.MustCompile(`^[\w\.@_/-]{1,128}$`)
+
+// secretsFormat returns explicitly configured format or the one inferred from file extension
+func secretsFormat(format, source string) string {
+	if format != "" {
+		return format
+	}
+	switch strings.ToLower(filepath.Ext(source)) {
+	case ".json":
+		return "json"
+	case ".yaml", ".yml":
+		return "yaml"
+	}
+	return "dotenv"
+}
+
+// parseDotenv reads KEY=VALUE lines, skipping blank lines and comments
+func parseDotenv(content []byte) (map[string]string, error) {
+	secrets := map[string]string{}
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		text = strings.TrimPrefix(text, "export ")
+		parts := strings.SplitN(text, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("line %d: expected KEY=VALUE", line)
+		}
+		key := strings.TrimSpace(parts[0])
+		value := strings.TrimSpace(parts[1])
+		switch {
+		case len(value) >= 2 && value[0] == '"' && value[len(value)-1] == '"':
+			unquoted, err := strconv.Unquote(value)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", line, err)
+			}
+			value = unquoted
+		case len(value) >= 2 && value[0] == '\'' && value[len(value)-1] == '\'':
+			value = value[1 : len(value)-1]
+		}
+		secrets[key] = value
+	}
+	return secrets, scanner.Err()
+}
+
+// parseSecrets reads flat key/value pairs from dotenv, JSON or YAML content
+func parseSecrets(content []byte, format string) (secrets map[string]string, err error) {
+	switch format {
+	case "dotenv":
+		secrets, err = parseDotenv(content)
+	case "json":
+		err = json.Unmarshal(content, &secrets)
+	case "yaml":
+		err = yaml.Unmarshal(content, &secrets)
+	default:
+		err = fmt.Errorf("unsupported format: %s", format)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse %s: %w", format, err)
+	}
+	for key := range secrets {
+		if !validSecretKey.MatchString(key) {
+			return nil, fmt.Errorf("invalid secret key: %s", key)
+		}
+	}
+	return secrets, nil
+}
+
+type secretsGetter interface {
+	Get(string) interface{}
+}
+
+// desiredSecrets returns secrets either from `secrets` map or from `source` file
+func desiredSecrets(d secretsGetter) (map[string]string, error) {
+	source := d.Get("source").(string)
+	if source == "" {
+		secrets := map[string]string{}
+		for k, v := range d.Get("secrets").(map[string]interface{}) {
+			secrets[k] = v.(string)
+		}
+		return secrets, nil
+	}
+	content, err := ioutil.ReadFile(source)
+	if err != nil {
+		return nil, err
+	}
+	return parseSecrets(content, secretsFormat(d.Get("format").(string), source))
+}
+
+func secretChecksum(value string) string {
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(value)))
+}
+
+func secretChecksums(secrets map[string]string) map[string]interface{} {
+	checksums := map[string]interface{}{}
+	for k, v := range secrets {
+		checksums[k] = secretChecksum(v)
+	}
+	return checksums
+}
+
+func stringMap(raw interface{}) map[string]string {
+	result := map[string]string{}
+	m, _ := raw.(map[string]interface{})
+	for k, v := range m {
+		result[k] = fmt.Sprint(v)
+	}
+	return result
+}
+
+// readSecretsState records keys and timestamps of all secrets in a scope. Checksums are kept only
+// for secrets, that were not modified outside of Terraform, so that the rest is planned for update.
+func readSecretsState(d *schema.ResourceData, secrets []SecretMetadata) error {
+	checksums := stringMap(d.Get("checksums"))
+	lastUpdated := stringMap(d.Get("last_updated"))
+	newChecksums := map[string]interface{}{}
+	newLastUpdated := map[string]interface{}{}
+	keys := []string{}
+	for _, secret := range secrets {
+		timestamp := fmt.Sprint(secret.LastUpdatedTimestamp)
+		keys = append(keys, secret.Key)
+		newLastUpdated[secret.Key] = timestamp
+		if lastUpdated[secret.Key] == timestamp {
+			newChecksums[secret.Key] = checksums[secret.Key]
+		} else {
+			log.Printf("[INFO] Secret %s/%s was modified outside of Terraform",
+				d.Id(), secret.Key)
+			newChecksums[secret.Key] = ""
+		}
+	}
+	sort.Strings(keys)
+	if err := d.Set("keys", keys); err != nil {
+		return err
+	}
+	if err := d.Set("last_updated", newLastUpdated); err != nil {
+		return err
+	}
+	return d.Set("checksums", newChecksums)
+}
+
+// ResourceSecrets authoritatively manages all secrets of a scope
+func ResourceSecrets() *schema.Resource {
+	s := map[string]*schema.Schema{
+		"scope": {
+			Type:         schema.TypeString,
+			ValidateFunc: validScope,
+			Required:     true,
+			ForceNew:     true,
+		},
+		"secrets": {
+			Type:         schema.TypeMap,
+			Elem:         &schema.Schema{Type: schema.TypeString},
+			Optional:     true,
+			Sensitive:    true,
+			ExactlyOneOf: []string{"secrets", "source"},
+		},
+		"source": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ExactlyOneOf: []string{"secrets", "source"},
+		},
+		"format": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice([]string{"dotenv", "json", "yaml"}, false),
+		},
+		"keys": {
+			Type:     schema.TypeSet,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+			Computed: true,
+		},
+		"last_updated": {
+			Type:     schema.TypeMap,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+			Computed: true,
+		},
+		"checksums": {
+			Type:      schema.TypeMap,
+			Elem:      &schema.Schema{Type: schema.TypeString},
+			Computed:  true,
+			Sensitive: true,
+		},
+	}
+	// apply puts secrets with changed checksums and removes the ones, that are not declared
+	apply := func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+		scope := d.Get("scope").(string)
+		desired, err := desiredSecrets(d)
+		if err != nil {
+			return err
+		}
+		oldChecksums, _ := d.GetChange("checksums")
+		checksums := stringMap(oldChecksums)
+		secretsAPI := NewSecretsAPI(ctx, c)
+		remote, err := secretsAPI.List(scope)
+		if err != nil {
+			return err
+		}
+		existing := map[string]bool{}
+		for _, secret := range remote {
+			existing[secret.Key] = true
+		}
+		keys := []string{}
+		for k := range desired {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			checksum := secretChecksum(desired[k])
+			if existing[k] && checksums[k] == checksum {
+				continue
+			}
+			log.Printf("[INFO] Putting secret %s/%s", scope, k)
+			if err = secretsAPI.Create(desired[k], scope, k); err != nil {
+				return err
+			}
+			checksums[k] = checksum
+		}
+		for _, secret := range remote {
+			if _, ok := desired[secret.Key]; ok {
+				continue
+			}
+			log.Printf("[INFO] Removing undeclared secret %s/%s", scope, secret.Key)
+			if err = secretsAPI.Delete(scope, secret.Key); err != nil {
+				return err
+			}
+		}
+		d.SetId(scope)
+		remote, err = secretsAPI.List(scope)
+		if err != nil {
+			return err
+		}
+		lastUpdated := map[string]interface{}{}
+		for _, secret := range remote {
+			lastUpdated[secret.Key] = fmt.Sprint(secret.LastUpdatedTimestamp)
+		}
+		d.Set("last_updated", lastUpdated)
+		newChecksums := map[string]interface{}{}
+		for k := range desired {
+			newChecksums[k] = checksums[k]
+		}
+		return d.Set("checksums", newChecksums)
+	}
+	return common.Resource{
+		Schema: s,
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, c interface{}) error {
+			desired, err := desiredSecrets(d)
+			if err != nil {
+				return err
+			}
+			checksums := secretChecksums(desired)
+			if fmt.Sprint(stringMap(d.Get("checksums"))) == fmt.Sprint(checksums) {
+				return nil
+			}
+			keys := []interface{}{}
+			for k := range desired {
+				keys = append(keys, k)
+			}
+			if err = d.SetNew("keys", keys); err != nil {
+				return err
+			}
+			if err = d.SetNewComputed("last_updated"); err != nil {
+				return err
+			}
+			return d.SetNew("checksums", checksums)
+		},
+		Create: apply,
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			secrets, err := NewSecretsAPI(ctx, c).List(d.Id())
+			if err != nil {
+				return err
+			}
+			d.Set("scope", d.Id())
+			return readSecretsState(d, secrets)
+		},
+		Update: apply,
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			secretsAPI := NewSecretsAPI(ctx, c)
+			for _, k := range d.Get("keys").(*schema.Set).List() {
+				err := secretsAPI.Delete(d.Id(), k.(string))
+				if err != nil && !common.IsMissing(err) {
+					return err
+				}
+			}
+			return nil
+		},
+	}.ToResource()
+}
@@ -0,0 +1,253 @@
+package access
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/databrickslabs/terraform-provider-databricks/qa"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSecrets(t *testing.T) {
+	secrets, err := parseSecrets([]byte(`
+# database
+export DB_USER=admin
+DB_PASSWORD="p@ss\nword"
+TOKEN='a=b'
+`), "dotenv")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"DB_USER":     "admin",
+		"DB_PASSWORD": "p@ss\nword",
+		"TOKEN":       "a=b",
+	}, secrets)
+
+	secrets, err = parseSecrets([]byte(`{"a": "b", "c.d": "e"}`), "json")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"a": "b", "c.d": "e"}, secrets)
+
+	secrets, err = parseSecrets([]byte("a: b\nc: 'd'\n"), "yaml")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"a": "b", "c": "d"}, secrets)
+
+	_, err = parseSecrets([]byte("NOPE"), "dotenv")
+	assert.EqualError(t, err, "cannot parse dotenv: line 1: expected KEY=VALUE")
+
+	_, err = parseSecrets([]byte(`{"a": {"b": "c"}}`), "json")
+	assert.Error(t, err)
+
+	_, err = parseSecrets([]byte(`{"a b": "c"}`), "json")
+	assert.EqualError(t, err, "invalid secret key: a b")
+}
+
+func TestSecretsFormat(t *testing.T) {
+	assert.Equal(t, "json", secretsFormat("", "a/b.JSON"))
+	assert.Equal(t, "yaml", secretsFormat("", "b.yml"))
+	assert.Equal(t, "dotenv", secretsFormat("", ".env"))
+	assert.Equal(t, "yaml", secretsFormat("yaml", "secrets.txt"))
+}
+
+func TestResourceSecretsCreate(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/secrets/list?scope=foo",
+				Response: SecretsList{
+					Secrets: []SecretMetadata{
+						{Key: "stale", LastUpdatedTimestamp: 1},
+					},
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/secrets/put",
+				ExpectedRequest: SecretsRequest{
+					StringValue: "b",
+					Scope:       "foo",
+					Key:         "a",
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/secrets/put",
+				ExpectedRequest: SecretsRequest{
+					StringValue: "d",
+					Scope:       "foo",
+					Key:         "c",
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/secrets/delete",
+				ExpectedRequest: SecretsRequest{
+					Scope: "foo",
+					Key:   "stale",
+				},
+			},
+			{
+				Method:       "GET",
+				Resource:     "/api/2.0/secrets/list?scope=foo",
+				ReuseRequest: true,
+				Response: SecretsList{
+					Secrets: []SecretMetadata{
+						{Key: "a", LastUpdatedTimestamp: 10},
+						{Key: "c", LastUpdatedTimestamp: 11},
+					},
+				},
+			},
+		},
+		Resource: ResourceSecrets(),
+		Create:   true,
+		HCL: `scope = "foo"
+		secrets = {
+			a = "b"
+			c = "d"
+		}`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "foo", d.Id())
+	assert.Equal(t, 2, d.Get("keys").(*schema.Set).Len())
+	assert.Equal(t, "11", d.Get("last_updated.c"))
+	assert.Equal(t, secretChecksum("b"), d.Get("checksums.a"))
+}
+
+func TestResourceSecretsUpdate_OnlyChanged(t *testing.T) {
+	dir := t.TempDir()
+	source := filepath.Join(dir, "secrets.json")
+	err := ioutil.WriteFile(source, []byte(`{"a": "b", "c": "new"}`), 0600)
+	require.NoError(t, err)
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/secrets/list?scope=foo",
+				Response: SecretsList{
+					Secrets: []SecretMetadata{
+						{Key: "a", LastUpdatedTimestamp: 10},
+						{Key: "c", LastUpdatedTimestamp: 11},
+					},
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/secrets/put",
+				ExpectedRequest: SecretsRequest{
+					StringValue: "new",
+					Scope:       "foo",
+					Key:         "c",
+				},
+			},
+			{
+				Method:       "GET",
+				Resource:     "/api/2.0/secrets/list?scope=foo",
+				ReuseRequest: true,
+				Response: SecretsList{
+					Secrets: []SecretMetadata{
+						{Key: "a", LastUpdatedTimestamp: 10},
+						{Key: "c", LastUpdatedTimestamp: 12},
+					},
+				},
+			},
+		},
+		Resource: ResourceSecrets(),
+		Update:   true,
+		ID:       "foo",
+		InstanceState: map[string]string{
+			"scope":          "foo",
+			"source":         source,
+			"checksums.%":    "2",
+			"checksums.a":    secretChecksum("b"),
+			"checksums.c":    secretChecksum("old"),
+			"last_updated.%": "2",
+			"last_updated.a": "10",
+			"last_updated.c": "11",
+		},
+		HCL: `scope = "foo"
+		source = "` + source + `"`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "12", d.Get("last_updated.c"))
+	assert.Equal(t, secretChecksum("new"), d.Get("checksums.c"))
+}
+
+func TestResourceSecretsRead_ModifiedOutside(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/secrets/list?scope=foo",
+				Response: SecretsList{
+					Secrets: []SecretMetadata{
+						{Key: "a", LastUpdatedTimestamp: 10},
+						{Key: "c", LastUpdatedTimestamp: 99},
+						{Key: "manual", LastUpdatedTimestamp: 5},
+					},
+				},
+			},
+		},
+		Resource: ResourceSecrets(),
+		Read:     true,
+		ID:       "foo",
+		InstanceState: map[string]string{
+			"scope":          "foo",
+			"checksums.%":    "2",
+			"checksums.a":    secretChecksum("b"),
+			"checksums.c":    secretChecksum("d"),
+			"last_updated.%": "2",
+			"last_updated.a": "10",
+			"last_updated.c": "11",
+		},
+		HCL: `scope = "foo"
+		secrets = {
+			a = "b"
+			c = "d"
+		}`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, secretChecksum("b"), d.Get("checksums.a"))
+	assert.Equal(t, "", d.Get("checksums.c"))
+	assert.Equal(t, "", d.Get("checksums.manual"))
+	assert.Equal(t, 3, d.Get("keys").(*schema.Set).Len())
+}
+
+func TestResourceSecretsDelete(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/secrets/delete",
+				ExpectedRequest: SecretsRequest{
+					Scope: "foo",
+					Key:   "a",
+				},
+			},
+		},
+		Resource: ResourceSecrets(),
+		Delete:   true,
+		ID:       "foo",
+		InstanceState: map[string]string{
+			"scope":           "foo",
+			"keys.#":          "1",
+			"keys.3904355907": "a",
+		},
+		HCL: `scope = "foo"
+		secrets = {
+			a = "b"
+		}`,
+	}.ApplyNoError(t)
+}
+
+func TestResourceSecretsCreate_MissingSource(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceSecrets(),
+		Create:   true,
+		HCL: `scope = "foo"
+		source = "` + filepath.Join(os.TempDir(), "does-not-exist.env") + `"`,
+	}.ExpectError(t, "open "+filepath.Join(os.TempDir(), "does-not-exist.env")+
+		": no such file or directory")
+}
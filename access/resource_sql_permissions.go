@@ -22,7 +22,10 @@ type SqlPermissions struct {
 	Catalog              bool                  `json:"catalog,omitempty" tf:"force_new"`
 	AnyFile              bool                  `json:"any_file,omitempty" tf:"force_new"`
 	AnonymousFunction    bool                  `json:"anonymous_function,omitempty" tf:"force_new"`
+	Function             string                `json:"function,omitempty" tf:"force_new"`
+	Owner                string                `json:"owner,omitempty" tf:"computed"`
 	ClusterID            string                `json:"cluster_id,omitempty" tf:"computed"`
+	SqlEndpointID        string                `json:"sql_endpoint_id,omitempty"`
 	PrivilegeAssignments []PrivilegeAssignment `json:"privilege_assignments,omitempty" tf:"slice_set"`
 
 	exec common.CommandExecutor
@@ -30,8 +33,9 @@ type SqlPermissions struct {
 
 // PrivilegeAssignment ...
 type PrivilegeAssignment struct {
-	Principal  string   `json:"principal"`
-	Privileges []string `json:"privileges" tf:"slice_set"`
+	Principal        string   `json:"principal"`
+	Privileges       []string `json:"privileges,omitempty" tf:"slice_set"`
+	DeniedPrivileges []string `json:"denied_privileges,omitempty" tf:"slice_set"`
 }
 
 func (ta *SqlPermissions) actualDatabase() string {
@@ -49,6 +53,9 @@ func (ta *SqlPermissions) typeAndKey() (string, string) {
 	if ta.View != "" {
 		return "VIEW", fmt.Sprintf("`%s`.`%s`", ta.actualDatabase(), ta.View)
 	}
+	if ta.Function != "" {
+		return "FUNCTION", fmt.Sprintf("`%s`.`%s`", ta.actualDatabase(), ta.Function)
+	}
 	if ta.Database != "" {
 		return "DATABASE", ta.Database
 	}
@@ -97,6 +104,13 @@ func loadTableACL(id string) (SqlPermissions, error) {
 		}
 		ta.Database = dav[0]
 		ta.Table = dav[1]
+	case "function":
+		dav := strings.SplitN(split[1], ".", 2)
+		if len(dav) != 2 {
+			return ta, fmt.Errorf("function must have two elements")
+		}
+		ta.Database = dav[0]
+		ta.Function = dav[1]
 	case "catalog":
 		ta.Catalog = true
 	case "any file":
@@ -109,6 +123,14 @@ func loadTableACL(id string) (SqlPermissions, error) {
 	return ta, nil
 }
 
+// grant is a row of SHOW GRANT results
+type grant struct {
+	Principal  string `json:"Principal"`
+	ActionType string `json:"ActionType"`
+	ObjectType string `json:"ObjectType"`
+	ObjectKey  string `json:"ObjectKey"`
+}
+
 func (ta *SqlPermissions) read() error {
 	thisType, thisKey := ta.typeAndKey()
 	if thisType == "" && thisKey == "" {
@@ -126,49 +148,52 @@ func (ta *SqlPermissions) read() error {
 	}
 	// clear any previous entries
 	ta.PrivilegeAssignments = []PrivilegeAssignment{}
+	ta.Owner = ""
 
+	var grants []grant
+	err := currentGrantsOnThis.Rows(&grants)
+	if err != nil {
+		return fmt.Errorf("cannot read current grants: %w", err)
+	}
 	// iterate over existing permissions over given data object
-	var currentPrincipal, currentAction, currentType, currentKey string
-	for currentGrantsOnThis.Scan(&currentPrincipal, &currentAction, &currentType, &currentKey) {
-		if currentType == "CATALOG$" {
-			currentType = "CATALOG"
-			currentKey = ""
-		}
-		if !strings.EqualFold(currentType, thisType) {
-			continue
+	for _, g := range grants {
+		if g.ObjectType == "CATALOG$" {
+			g.ObjectType = "CATALOG"
+			g.ObjectKey = ""
 		}
-		if !strings.EqualFold(currentKey, thisKey) {
+		if !strings.EqualFold(g.ObjectType, thisType) {
 			continue
 		}
-		if strings.HasPrefix(currentAction, "DENIED_") {
-			// DENY statements are intentionally not supported.
+		if !strings.EqualFold(g.ObjectKey, thisKey) {
 			continue
 		}
-		if currentAction == "OWN" {
-			// skip table ownership definitions for now
+		if g.ActionType == "OWN" {
+			ta.Owner = g.Principal
 			continue
 		}
 		// find existing grants for all principals
-		var privileges *[]string
+		var assignment *PrivilegeAssignment
 		for i, privilegeAssignment := range ta.PrivilegeAssignments {
 			// correct all privileges for the same principal into a slide
-			if privilegeAssignment.Principal == currentPrincipal {
-				privileges = &ta.PrivilegeAssignments[i].Privileges
+			if privilegeAssignment.Principal == g.Principal {
+				assignment = &ta.PrivilegeAssignments[i]
 			}
 		}
-		if privileges == nil {
+		if assignment == nil {
 			// initialize permissions wrapper for a principal not seen
 			// in previous iterations
-			firstSeenPrincipalPermissions := PrivilegeAssignment{
-				Principal:  currentPrincipal,
-				Privileges: []string{},
-			}
-			// point privileges to be of the newly added principal
-			ta.PrivilegeAssignments = append(ta.PrivilegeAssignments, firstSeenPrincipalPermissions)
-			privileges = &ta.PrivilegeAssignments[len(ta.PrivilegeAssignments)-1].Privileges
+			ta.PrivilegeAssignments = append(ta.PrivilegeAssignments, PrivilegeAssignment{
+				Principal: g.Principal,
+			})
+			assignment = &ta.PrivilegeAssignments[len(ta.PrivilegeAssignments)-1]
 		}
 		// add action for the principal on current iteration
-		*privileges = append(*privileges, currentAction)
+		if strings.HasPrefix(g.ActionType, "DENIED_") {
+			assignment.DeniedPrivileges = append(assignment.DeniedPrivileges,
+				strings.TrimPrefix(g.ActionType, "DENIED_"))
+			continue
+		}
+		assignment.Privileges = append(assignment.Privileges, g.ActionType)
 	}
 	return nil
 }
@@ -195,19 +220,65 @@ func (ta *SqlPermissions) revoke() error {
 }
 
 func (ta *SqlPermissions) enforce() (err error) {
+	for _, privilegeAssignment := range ta.PrivilegeAssignments {
+		if len(privilegeAssignment.Privileges) == 0 && len(privilegeAssignment.DeniedPrivileges) == 0 {
+			return fmt.Errorf("privilege_assignments for %s must have privileges or denied_privileges",
+				privilegeAssignment.Principal)
+		}
+	}
 	if err = ta.revoke(); err != nil {
 		return err
 	}
 	for _, privilegeAssignment := range ta.PrivilegeAssignments {
-		if err = ta.apply(func(objType, key string) string {
-			privileges := strings.Join(privilegeAssignment.Privileges, ", ")
-			return fmt.Sprintf("GRANT %s ON %s %s TO `%s`",
-				privileges, objType, key, privilegeAssignment.Principal)
-		}); err != nil {
-			return err
+		if len(privilegeAssignment.Privileges) > 0 {
+			if err = ta.apply(func(objType, key string) string {
+				privileges := strings.Join(privilegeAssignment.Privileges, ", ")
+				return fmt.Sprintf("GRANT %s ON %s %s TO `%s`",
+					privileges, objType, key, privilegeAssignment.Principal)
+			}); err != nil {
+				return err
+			}
+		}
+		if len(privilegeAssignment.DeniedPrivileges) > 0 {
+			if err = ta.apply(func(objType, key string) string {
+				privileges := strings.Join(privilegeAssignment.DeniedPrivileges, ", ")
+				return fmt.Sprintf("DENY %s ON %s %s TO `%s`",
+					privileges, objType, key, privilegeAssignment.Principal)
+			}); err != nil {
+				return err
+			}
 		}
 	}
-	return nil
+	return ta.transferOwnership()
+}
+
+// transferOwnership changes owner of the object, if it's different from the current one.
+// It's done after grants, as the new owner may not be the principal running Terraform.
+func (ta *SqlPermissions) transferOwnership() error {
+	if ta.Owner == "" {
+		return nil
+	}
+	objType, _ := ta.typeAndKey()
+	switch objType {
+	case "TABLE", "VIEW", "DATABASE", "FUNCTION":
+	default:
+		return fmt.Errorf("owner cannot be set for %s", strings.ToLower(objType))
+	}
+	current, err := loadTableACL(ta.ID())
+	if err != nil {
+		return err
+	}
+	current.exec = ta.exec
+	current.ClusterID = ta.ClusterID
+	if err = current.read(); err != nil {
+		return err
+	}
+	if current.Owner == ta.Owner {
+		return nil
+	}
+	return ta.apply(func(objType, key string) string {
+		return fmt.Sprintf("ALTER %s %s OWNER TO `%s`", objType, key, ta.Owner)
+	})
 }
 
 func (ta *SqlPermissions) apply(qb func(objType, key string) string) error {
@@ -224,6 +295,17 @@ func (ta *SqlPermissions) apply(qb func(objType, key string) string) error {
 	return fmt.Errorf("cannot execute %s: %s", sqlQuery, r.Error())
 }
 
+// initExecutor prepares either SQL endpoint or High-Concurrency cluster to run GRANT and REVOKE statements
+func (ta *SqlPermissions) initExecutor(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+	if v, ok := d.GetOk("sql_endpoint_id"); ok {
+		ta.SqlEndpointID = v.(string)
+		ta.ClusterID = ""
+		ta.exec = newSqlStatementExecutor(ctx, c, ta.SqlEndpointID)
+		return nil
+	}
+	return ta.initCluster(ctx, d, c)
+}
+
 func (ta *SqlPermissions) initCluster(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) (err error) {
 	clustersAPI := clusters.NewClustersAPI(ctx, c)
 	if ci, ok := d.GetOk("cluster_id"); ok {
@@ -287,7 +369,7 @@ func tableAclForUpdate(ctx context.Context, d *schema.ResourceData,
 	if err = common.DataToStructPointer(d, s, &ta); err != nil {
 		return
 	}
-	err = ta.initCluster(ctx, d, c)
+	err = ta.initExecutor(ctx, d, c)
 	return
 }
 
@@ -297,14 +379,14 @@ func tableAclForLoad(ctx context.Context, d *schema.ResourceData,
 	if err != nil {
 		return
 	}
-	err = ta.initCluster(ctx, d, c)
+	err = ta.initExecutor(ctx, d, c)
 	return
 }
 
 // ResourceSqlPermissions manages table ACLs
 func ResourceSqlPermissions() *schema.Resource {
 	s := common.StructToSchema(SqlPermissions{}, func(s map[string]*schema.Schema) map[string]*schema.Schema {
-		alof := []string{"database", "table", "view", "catalog", "any_file", "anonymous_function"}
+		alof := []string{"database", "table", "view", "catalog", "any_file", "anonymous_function", "function"}
 		for _, field := range alof {
 			s[field].AtLeastOneOf = alof
 		}
@@ -315,6 +397,8 @@ func ResourceSqlPermissions() *schema.Resource {
 			return false
 		}
 		s["cluster_id"].Computed = true
+		s["cluster_id"].ConflictsWith = []string{"sql_endpoint_id"}
+		s["sql_endpoint_id"].ConflictsWith = []string{"cluster_id"}
 		return s
 	})
 	return common.Resource{
@@ -17,6 +17,7 @@ func TestTableACLID(t *testing.T) {
 		"view/bar.foo":        {View: "foo", Database: "bar"},
 		"database/bar":        {Database: "bar"},
 		"catalog/":            {Catalog: true},
+		"function/bar.foo":    {Function: "foo", Database: "bar"},
 		"any file/":           {AnyFile: true},
 		"anonymous function/": {AnonymousFunction: true},
 	} {
@@ -32,10 +33,11 @@ func TestTableACLID(t *testing.T) {
 
 func TestTableACLID_errors(t *testing.T) {
 	for id, exp := range map[string]string{
-		"table":      "ID must be two elements: table",
-		"table/beep": "table must have two elements",
-		"view/beep":  "view must have two elements",
-		"vuew/beep":  "illegal ID type: vuew",
+		"table":         "ID must be two elements: table",
+		"table/beep":    "table must have two elements",
+		"view/beep":     "view must have two elements",
+		"function/beep": "function must have two elements",
+		"vuew/beep":     "illegal ID type: vuew",
 	} {
 		_, err := loadTableACL(id)
 		assert.EqualError(t, err, exp)
@@ -63,6 +65,12 @@ func (md mockData) Execute(clusterID, language, commandStr string) common.Comman
 	return common.CommandResults{
 		ResultType: "table",
 		Data:       x,
+		Schema: []interface{}{
+			map[string]interface{}{"name": "Principal", "type": `"string"`},
+			map[string]interface{}{"name": "ActionType", "type": `"string"`},
+			map[string]interface{}{"name": "ObjectType", "type": `"string"`},
+			map[string]interface{}{"name": "ObjectKey", "type": `"string"`},
+		},
 	}
 }
 
@@ -85,8 +93,10 @@ func TestTableACLGrants(t *testing.T) {
 	}}
 	err := ta.read()
 	assert.NoError(t, err)
-	assert.Len(t, ta.PrivilegeAssignments, 1)
-	assert.Len(t, ta.PrivilegeAssignments[0].Privileges, 2)
+	assert.Equal(t, []PrivilegeAssignment{
+		{Principal: "users", Privileges: []string{"SELECT", "READ"}},
+		{Principal: "interns", DeniedPrivileges: []string{"SELECT"}},
+	}, ta.PrivilegeAssignments)
 }
 
 type failedCommand string
@@ -135,8 +145,8 @@ func TestTableACL_Enforce(t *testing.T) {
 	ta := SqlPermissions{
 		Table: "foo",
 		PrivilegeAssignments: []PrivilegeAssignment{
-			{"engineers", []string{"MODIFY", "SELECT", "READ"}},
-			{"support", []string{"SELECT"}},
+			{Principal: "engineers", Privileges: []string{"MODIFY", "SELECT", "READ"}},
+			{Principal: "support", Privileges: []string{"SELECT"}},
 		},
 		exec: mockData{
 			"SHOW GRANT ON TABLE `default`.`foo`": {
@@ -433,3 +443,184 @@ func TestResourceSqlPermissions_Delete(t *testing.T) {
 func TestResourceSqlPermissions_CornerCases(t *testing.T) {
 	qa.ResourceCornerCases(t, ResourceSqlPermissions(), qa.CornerCaseID("database/foo"))
 }
+
+func showGrantResponse(rows ...[]interface{}) statementResponse {
+	return statementResponse{
+		StatementID: "s1",
+		Status: statementStatus{
+			State: "SUCCEEDED",
+		},
+		Manifest: &statementManifest{
+			Schema: statementSchema{
+				Columns: []statementColumn{
+					{Name: "Principal", TypeName: "STRING", Position: 0},
+					{Name: "ActionType", TypeName: "STRING", Position: 1},
+					{Name: "ObjectType", TypeName: "STRING", Position: 2},
+					{Name: "ObjectKey", TypeName: "STRING", Position: 3},
+				},
+			},
+		},
+		Result: &statementResult{
+			DataArray: rows,
+		},
+	}
+}
+
+func statementFixture(statement string, response statementResponse) qa.HTTPFixture {
+	return qa.HTTPFixture{
+		Method:   "POST",
+		Resource: "/api/2.0/sql/statements",
+		ExpectedRequest: statementRequest{
+			WarehouseID: "def",
+			Statement:   statement,
+			WaitTimeout: "30s",
+			Disposition: "INLINE",
+			Format:      "JSON_ARRAY",
+		},
+		Response: response,
+	}
+}
+
+func TestResourceSqlPermissions_CreateWithSqlEndpoint(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			statementFixture("SHOW GRANT ON TABLE `default`.`foo`",
+				showGrantResponse([]interface{}{"users", "SELECT", "TABLE", "`default`.`foo`"})),
+			statementFixture("REVOKE ALL PRIVILEGES ON TABLE `default`.`foo` FROM `users`",
+				showGrantResponse()),
+			statementFixture("GRANT MODIFY, SELECT ON TABLE `default`.`foo` TO `serge@example.com`",
+				showGrantResponse()),
+			statementFixture("SHOW GRANT ON TABLE `default`.`foo`",
+				showGrantResponse(
+					[]interface{}{"serge@example.com", "MODIFY", "TABLE", "`default`.`foo`"},
+					[]interface{}{"serge@example.com", "SELECT", "TABLE", "`default`.`foo`"})),
+		},
+		HCL: `
+		table = "foo"
+		sql_endpoint_id = "def"
+		privilege_assignments {
+			principal = "serge@example.com"
+			privileges = ["SELECT", "MODIFY"]
+		}
+		`,
+		Resource: ResourceSqlPermissions(),
+		Create:   true,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "table/default.foo", d.Id())
+	assert.Equal(t, "def", d.Get("sql_endpoint_id"))
+	assert.Equal(t, "", d.Get("cluster_id"))
+}
+
+func TestResourceSqlPermissions_ConflictingExecutors(t *testing.T) {
+	qa.ResourceFixture{
+		HCL: `
+		table = "foo"
+		cluster_id = "abc"
+		sql_endpoint_id = "def"
+		`,
+		Resource: ResourceSqlPermissions(),
+		Create:   true,
+	}.ExpectError(t, "invalid config supplied. [cluster_id] Conflicting configuration arguments. "+
+		"[sql_endpoint_id] Conflicting configuration arguments")
+}
+
+func TestTableACL_EnforceDenyAndOwner(t *testing.T) {
+	ta := SqlPermissions{
+		Function: "bar",
+		Owner:    "admins",
+		PrivilegeAssignments: []PrivilegeAssignment{
+			{Principal: "engineers", Privileges: []string{"SELECT"}},
+			{Principal: "interns", DeniedPrivileges: []string{"SELECT", "READ_METADATA"}},
+		},
+		exec: mockData{
+			"SHOW GRANT ON FUNCTION `default`.`bar`": {
+				{"serge@example.com", "OWN", "function", "`default`.`bar`"},
+				{"interns", "DENIED_SELECT", "function", "`default`.`bar`"},
+			},
+			"REVOKE ALL PRIVILEGES ON FUNCTION `default`.`bar` FROM `interns`":    {},
+			"GRANT SELECT ON FUNCTION `default`.`bar` TO `engineers`":             {},
+			"DENY SELECT, READ_METADATA ON FUNCTION `default`.`bar` TO `interns`": {},
+			"ALTER FUNCTION `default`.`bar` OWNER TO `admins`":                    {},
+		},
+	}
+	err := ta.enforce()
+	require.NoError(t, err)
+}
+
+func TestTableACL_EnforceEmptyAssignment(t *testing.T) {
+	ta := SqlPermissions{
+		Table: "foo",
+		PrivilegeAssignments: []PrivilegeAssignment{
+			{Principal: "interns"},
+		},
+		// nothing is revoked before validation
+		exec: mockData{},
+	}
+	err := ta.enforce()
+	assert.EqualError(t, err, "privilege_assignments for interns must have privileges or denied_privileges")
+}
+
+func TestTableACL_EnforceOwnerUnchanged(t *testing.T) {
+	ta := SqlPermissions{
+		Database: "foo",
+		Owner:    "admins",
+		exec: mockData{
+			"SHOW GRANT ON DATABASE foo": {
+				{"admins", "OWN", "database", "foo"},
+			},
+		},
+	}
+	err := ta.enforce()
+	require.NoError(t, err)
+}
+
+func TestTableACL_OwnerOfCatalog(t *testing.T) {
+	ta := SqlPermissions{
+		Catalog: true,
+		Owner:   "admins",
+		exec: mockData{
+			"SHOW GRANT ON CATALOG ": {},
+		},
+	}
+	err := ta.enforce()
+	assert.EqualError(t, err, "owner cannot be set for catalog")
+}
+
+func TestResourceSqlPermissions_ReadOwnerAndDenied(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		CommandMock: mockData{
+			"SHOW GRANT ON FUNCTION `bar`.`foo`": {
+				{"serge@example.com", "OWN", "function", "`bar`.`foo`"},
+				{"users", "SELECT", "function", "`bar`.`foo`"},
+				{"interns", "DENIED_SELECT", "function", "`bar`.`foo`"},
+			},
+		}.toCommandMock(),
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:       "GET",
+				ReuseRequest: true,
+				Resource:     "/api/2.0/clusters/get?cluster_id=abc",
+				Response: clusters.ClusterInfo{
+					ClusterID: "abc",
+					State:     "RUNNING",
+					SparkConf: map[string]string{
+						"spark.databricks.acl.dfAclsEnabled": "true",
+					},
+				},
+			},
+		},
+		Resource: ResourceSqlPermissions(),
+		Read:     true,
+		New:      true,
+		ID:       "function/bar.foo",
+		HCL: `
+		database = "bar"
+		function = "foo"
+		cluster_id = "abc"
+		`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "serge@example.com", d.Get("owner"))
+	assert.Equal(t, 2, d.Get("privilege_assignments.#"))
+}
@@ -0,0 +1,149 @@
+package access
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+type statementRequest struct {
+	WarehouseID string `json:"warehouse_id"`
+	Statement   string `json:"statement"`
+	WaitTimeout string `json:"wait_timeout,omitempty"`
+	Disposition string `json:"disposition,omitempty"`
+	Format      string `json:"format,omitempty"`
+}
+
+type statementError struct {
+	ErrorCode string `json:"error_code,omitempty"`
+	Message   string `json:"message,omitempty"`
+}
+
+type statementStatus struct {
+	State string          `json:"state"`
+	Error *statementError `json:"error,omitempty"`
+}
+
+type statementColumn struct {
+	Name     string `json:"name"`
+	TypeName string `json:"type_name"`
+	Position int    `json:"position"`
+}
+
+type statementSchema struct {
+	Columns []statementColumn `json:"columns,omitempty"`
+}
+
+type statementManifest struct {
+	Schema    statementSchema `json:"schema"`
+	Truncated bool            `json:"truncated,omitempty"`
+}
+
+type statementResult struct {
+	DataArray [][]interface{} `json:"data_array,omitempty"`
+}
+
+type statementResponse struct {
+	StatementID string             `json:"statement_id"`
+	Status      statementStatus    `json:"status"`
+	Manifest    *statementManifest `json:"manifest,omitempty"`
+	Result      *statementResult   `json:"result,omitempty"`
+}
+
+// sqlStatementExecutor runs SQL through Databricks SQL endpoint with SQL Statement Execution API,
+// so that table ACLs could be managed without a dedicated interactive cluster
+type sqlStatementExecutor struct {
+	client     *common.DatabricksClient
+	context    context.Context
+	endpointID string
+	timeout    time.Duration
+}
+
+func newSqlStatementExecutor(ctx context.Context, c *common.DatabricksClient, endpointID string) sqlStatementExecutor {
+	return sqlStatementExecutor{
+		client:     c,
+		context:    ctx,
+		endpointID: endpointID,
+		// SQL endpoint may take a while to start
+		timeout: 20 * time.Minute,
+	}
+}
+
+// Execute runs SQL statement on the endpoint. Cluster ID and language are ignored.
+func (a sqlStatementExecutor) Execute(_, _, commandStr string) common.CommandResults {
+	var resp statementResponse
+	err := a.client.Post(a.context, "/sql/statements", statementRequest{
+		WarehouseID: a.endpointID,
+		Statement:   commandStr,
+		WaitTimeout: "30s",
+		Disposition: "INLINE",
+		Format:      "JSON_ARRAY",
+	}, &resp)
+	if err != nil {
+		return common.CommandResults{
+			ResultType: "error",
+			Summary:    err.Error(),
+		}
+	}
+	err = resource.RetryContext(a.context, a.timeout, func() *resource.RetryError {
+		switch resp.Status.State {
+		case "SUCCEEDED", "FAILED", "CANCELED", "CLOSED":
+			return nil
+		}
+		log.Printf("[DEBUG] Statement %s is %s", resp.StatementID, resp.Status.State)
+		err := a.client.Get(a.context, fmt.Sprintf("/sql/statements/%s", resp.StatementID), nil, &resp)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return resource.RetryableError(fmt.Errorf("statement %s is %s",
+			resp.StatementID, resp.Status.State))
+	})
+	if err != nil {
+		return common.CommandResults{
+			ResultType: "error",
+			Summary:    err.Error(),
+		}
+	}
+	return resp.commandResults()
+}
+
+// commandResults converts statement response to the same shape as results of Command Execution API
+func (r statementResponse) commandResults() common.CommandResults {
+	if r.Status.State != "SUCCEEDED" {
+		summary := fmt.Sprintf("statement %s is %s", r.StatementID, r.Status.State)
+		if r.Status.Error != nil {
+			summary = r.Status.Error.Message
+		}
+		return common.CommandResults{
+			ResultType: "error",
+			Summary:    summary,
+		}
+	}
+	schema := []interface{}{}
+	truncated := false
+	if r.Manifest != nil {
+		for _, c := range r.Manifest.Schema.Columns {
+			schema = append(schema, map[string]interface{}{
+				"name": c.Name,
+				"type": fmt.Sprintf("%q", c.TypeName),
+			})
+		}
+		truncated = r.Manifest.Truncated
+	}
+	data := []interface{}{}
+	if r.Result != nil {
+		for _, row := range r.Result.DataArray {
+			data = append(data, row)
+		}
+	}
+	return common.CommandResults{
+		ResultType: "table",
+		Schema:     schema,
+		Data:       data,
+		Truncated:  truncated,
+	}
+}
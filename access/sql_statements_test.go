@@ -0,0 +1,106 @@
+package access
+
+import (
+	"context"
+	"testing"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+	"github.com/databrickslabs/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSqlStatementExecutor_Poll(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "POST",
+			Resource: "/api/2.0/sql/statements",
+			ExpectedRequest: statementRequest{
+				WarehouseID: "def",
+				Statement:   "SHOW GRANT ON TABLE `default`.`foo`",
+				WaitTimeout: "30s",
+				Disposition: "INLINE",
+				Format:      "JSON_ARRAY",
+			},
+			Response: statementResponse{
+				StatementID: "s1",
+				Status: statementStatus{
+					State: "PENDING",
+				},
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/sql/statements/s1",
+			Response: statementResponse{
+				StatementID: "s1",
+				Status: statementStatus{
+					State: "RUNNING",
+				},
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/sql/statements/s1",
+			Response: showGrantResponse([]interface{}{"users", "SELECT", "TABLE", "`default`.`foo`"}),
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		cr := newSqlStatementExecutor(ctx, client, "def").Execute("", "sql",
+			"SHOW GRANT ON TABLE `default`.`foo`")
+		assert.NoError(t, cr.Err())
+		var grants []grant
+		err := cr.Rows(&grants)
+		assert.NoError(t, err)
+		assert.Equal(t, []grant{{"users", "SELECT", "TABLE", "`default`.`foo`"}}, grants)
+		assert.Equal(t, "STRING", cr.Columns()[0].Type)
+	})
+}
+
+func TestSqlStatementExecutor_Failed(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "POST",
+			Resource: "/api/2.0/sql/statements",
+			Response: statementResponse{
+				StatementID: "s1",
+				Status: statementStatus{
+					State: "FAILED",
+					Error: &statementError{
+						ErrorCode: "BAD_REQUEST",
+						Message:   "Table or view not found: foo",
+					},
+				},
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		cr := newSqlStatementExecutor(ctx, client, "def").Execute("", "sql",
+			"SHOW GRANT ON TABLE `default`.`foo`")
+		assert.EqualError(t, cr.Err(), "Table or view not found: foo")
+	})
+}
+
+func TestSqlStatementExecutor_Error(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "POST",
+			Resource: "/api/2.0/sql/statements",
+			Status:   404,
+			Response: common.APIErrorBody{
+				ErrorCode: "RESOURCE_DOES_NOT_EXIST",
+				Message:   "SQL warehouse def does not exist",
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		cr := newSqlStatementExecutor(ctx, client, "def").Execute("", "sql", "SELECT 1")
+		assert.EqualError(t, cr.Err(), "SQL warehouse def does not exist")
+	})
+}
+
+func TestSqlStatementExecutor_Canceled(t *testing.T) {
+	cr := statementResponse{
+		StatementID: "s1",
+		Status: statementStatus{
+			State: "CANCELED",
+		},
+	}.commandResults()
+	assert.EqualError(t, cr.Err(), "statement s1 is CANCELED")
+}
@@ -42,6 +42,17 @@ const (
 	AzureAvailabilitySpotWithFallback = "SPOT_WITH_FALLBACK_AZURE"
 )
 
+// https://docs.gcp.databricks.com/dev-tools/api/latest/clusters.html#gcpavailability
+const (
+	// GcpAvailabilityPreemptible is Preemptible instance type for clusters
+	GcpAvailabilityPreemptible = "PREEMPTIBLE_GCP"
+	// GcpAvailabilityOnDemand is OnDemand instance type for clusters
+	GcpAvailabilityOnDemand = "ON_DEMAND_GCP"
+	// GcpAvailabilityPreemptibleWithFallback is Preemptible instance type for clusters with option
+	// to fallback into on-demand if instance cannot be acquired
+	GcpAvailabilityPreemptibleWithFallback = "PREEMPTIBLE_WITH_FALLBACK_GCP"
+)
+
 // AzureDiskVolumeType is disk type on azure vms
 type AzureDiskVolumeType string
 
@@ -62,6 +73,34 @@ const (
 	EbsVolumeTypeThroughputOptimizedHdd = "THROUGHPUT_OPTIMIZED_HDD"
 )
 
+// DataSecurityMode is the access mode of the cluster
+type DataSecurityMode string
+
+const (
+	// DataSecurityModeNone disables all security features
+	DataSecurityModeNone = "NONE"
+	// DataSecurityModeSingleUser allows only a single user, specified by `single_user_name`, to use the cluster
+	DataSecurityModeSingleUser = "SINGLE_USER"
+	// DataSecurityModeUserIsolation is for shared clusters with isolation between users
+	DataSecurityModeUserIsolation = "USER_ISOLATION"
+	// DataSecurityModeLegacyTableACL is for high concurrency clusters with table access control
+	DataSecurityModeLegacyTableACL = "LEGACY_TABLE_ACL"
+	// DataSecurityModeLegacyPassthrough is for high concurrency clusters with credential passthrough
+	DataSecurityModeLegacyPassthrough = "LEGACY_PASSTHROUGH"
+	// DataSecurityModeLegacySingleUser is for standard clusters with single user credential passthrough
+	DataSecurityModeLegacySingleUser = "LEGACY_SINGLE_USER"
+)
+
+// RuntimeEngine is the type of Databricks Runtime engine
+type RuntimeEngine string
+
+const (
+	// RuntimeEngineStandard is the default engine
+	RuntimeEngineStandard = "STANDARD"
+	// RuntimeEnginePhoton is vectorized query engine
+	RuntimeEnginePhoton = "PHOTON"
+)
+
 // ClusterState is for describing possible cluster states
 type ClusterState string
 
@@ -151,8 +190,12 @@ type AzureAttributes struct {
 // GcpAttributes encapsultes GCP specific attributes
 // https://docs.gcp.databricks.com/dev-tools/api/latest/clusters.html#clustergcpattributes
 type GcpAttributes struct {
-	UsePreemptibleExecutors bool   `json:"use_preemptible_executors,omitempty" tf:"computed"`
-	GoogleServiceAccount    string `json:"google_service_account,omitempty" tf:"computed"`
+	UsePreemptibleExecutors bool         `json:"use_preemptible_executors,omitempty" tf:"computed"`
+	GoogleServiceAccount    string       `json:"google_service_account,omitempty" tf:"computed"`
+	Availability            Availability `json:"availability,omitempty" tf:"computed"`
+	BootDiskSize            int32        `json:"boot_disk_size,omitempty" tf:"computed"`
+	LocalSsdCount           int32        `json:"local_ssd_count,omitempty" tf:"computed"`
+	ZoneID                  string       `json:"zone_id,omitempty" tf:"computed"`
 }
 
 // DbfsStorageInfo contains the destination string for DBFS
@@ -190,6 +233,31 @@ type InitScriptStorageInfo struct {
 	File *LocalFileInfo   `json:"file,omitempty" tf:"optional"`
 }
 
+// NetworkFileSystemInfo contains NFS server information for mounts
+type NetworkFileSystemInfo struct {
+	ServerAddress string `json:"server_address"`
+	MountOptions  string `json:"mount_options,omitempty"`
+}
+
+// MountInfo describes network file system, mounted on every node of the cluster
+type MountInfo struct {
+	NetworkFilesystemInfo *NetworkFileSystemInfo `json:"network_filesystem_info"`
+	RemoteMountDirPath    string                 `json:"remote_mount_dir_path,omitempty"`
+	LocalMountDirPath     string                 `json:"local_mount_dir_path"`
+}
+
+// WorkloadTypeClients defines kinds of clients, that can use the cluster
+type WorkloadTypeClients struct {
+	// both are always sent, as missing client is allowed by the API
+	Notebooks bool `json:"notebooks"`
+	Jobs      bool `json:"jobs"`
+}
+
+// WorkloadType restricts the types of workloads, that can run on the cluster
+type WorkloadType struct {
+	Clients *WorkloadTypeClients `json:"clients"`
+}
+
 // SparkNodeAwsAttributes is the struct that determines if the node is a spot instance or not
 type SparkNodeAwsAttributes struct {
 	IsSpot bool `json:"is_spot,omitempty"`
@@ -355,11 +423,49 @@ type Cluster struct {
 	ClusterLogConf *StorageInfo            `json:"cluster_log_conf,omitempty"`
 	DockerImage    *DockerImage            `json:"docker_image,omitempty"`
 
-	SingleUserName   string `json:"single_user_name,omitempty"`
-	IdempotencyToken string `json:"idempotency_token,omitempty" tf:"force_new"`
+	ClusterMountInfos []MountInfo   `json:"cluster_mount_infos,omitempty" tf:"alias:cluster_mount_info"`
+	WorkloadType      *WorkloadType `json:"workload_type,omitempty"`
+
+	SingleUserName   string           `json:"single_user_name,omitempty"`
+	DataSecurityMode DataSecurityMode `json:"data_security_mode,omitempty"`
+	RuntimeEngine    RuntimeEngine    `json:"runtime_engine,omitempty" tf:"computed"`
+	IdempotencyToken string           `json:"idempotency_token,omitempty" tf:"force_new"`
+}
+
+// validateDataSecurityMode skips checks of single_user_name, if it's not yet known during plan
+func (cluster Cluster) validateDataSecurityMode(singleUserNameKnown bool) error {
+	switch cluster.RuntimeEngine {
+	case "", RuntimeEngineStandard, RuntimeEnginePhoton:
+	default:
+		return fmt.Errorf("unknown runtime_engine: %s", cluster.RuntimeEngine)
+	}
+	switch cluster.DataSecurityMode {
+	case "", DataSecurityModeNone:
+		return nil
+	case DataSecurityModeSingleUser, DataSecurityModeLegacySingleUser:
+		if singleUserNameKnown && cluster.SingleUserName == "" {
+			return fmt.Errorf("data_security_mode %s requires single_user_name",
+				cluster.DataSecurityMode)
+		}
+		return nil
+	case DataSecurityModeUserIsolation, DataSecurityModeLegacyTableACL, DataSecurityModeLegacyPassthrough:
+		if singleUserNameKnown && cluster.SingleUserName != "" {
+			return fmt.Errorf("single_user_name cannot be used with data_security_mode %s",
+				cluster.DataSecurityMode)
+		}
+		if cluster.DockerImage != nil {
+			return fmt.Errorf("docker_image cannot be used with data_security_mode %s",
+				cluster.DataSecurityMode)
+		}
+		return nil
+	}
+	return fmt.Errorf("unknown data_security_mode: %s", cluster.DataSecurityMode)
 }
 
 func (cluster Cluster) Validate() error {
+	if err := cluster.validateDataSecurityMode(true); err != nil {
+		return err
+	}
 	// TODO: rewrite with CustomizeDiff
 	if cluster.NumWorkers > 0 || cluster.Autoscale != nil {
 		return nil
@@ -435,6 +541,10 @@ type ClusterInfo struct {
 	DriverInstancePoolID      string             `json:"driver_instance_pool_id,omitempty" tf:"computed"`
 	PolicyID                  string             `json:"policy_id,omitempty"`
 	SingleUserName            string             `json:"single_user_name,omitempty"`
+	DataSecurityMode          DataSecurityMode   `json:"data_security_mode,omitempty"`
+	RuntimeEngine             RuntimeEngine      `json:"runtime_engine,omitempty"`
+	WorkloadType              *WorkloadType      `json:"workload_type,omitempty"`
+	ClusterMountInfos         []MountInfo        `json:"cluster_mount_infos,omitempty" tf:"alias:cluster_mount_info"`
 	ClusterSource             Availability       `json:"cluster_source,omitempty"`
 	DockerImage               *DockerImage       `json:"docker_image,omitempty"`
 	State                     ClusterState       `json:"state"`
@@ -2,6 +2,7 @@ package clusters
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
 
@@ -1220,3 +1221,45 @@ func TestWrapMissingClusterError(t *testing.T) {
 		Message: "Cluster abc does not exist",
 	}, "abc"), "Cluster abc does not exist")
 }
+
+func TestClusterValidate_DataSecurityMode(t *testing.T) {
+	base := Cluster{NumWorkers: 1}
+	for _, tc := range []struct {
+		mode     DataSecurityMode
+		user     string
+		engine   RuntimeEngine
+		expected string
+	}{
+		{"", "", "", ""},
+		{DataSecurityModeNone, "", RuntimeEngineStandard, ""},
+		{DataSecurityModeSingleUser, "me@example.com", RuntimeEnginePhoton, ""},
+		{DataSecurityModeLegacySingleUser, "", "", "data_security_mode LEGACY_SINGLE_USER requires single_user_name"},
+		{DataSecurityModeUserIsolation, "", "", ""},
+		{DataSecurityModeLegacyPassthrough, "me@example.com", "", "single_user_name cannot be used with data_security_mode LEGACY_PASSTHROUGH"},
+		{"WHATEVER", "", "", "unknown data_security_mode: WHATEVER"},
+		{"", "", "TURBO", "unknown runtime_engine: TURBO"},
+	} {
+		cluster := base
+		cluster.DataSecurityMode = tc.mode
+		cluster.SingleUserName = tc.user
+		cluster.RuntimeEngine = tc.engine
+		err := cluster.Validate()
+		if tc.expected == "" {
+			assert.NoError(t, err)
+		} else {
+			assert.EqualError(t, err, tc.expected)
+		}
+	}
+}
+
+func TestWorkloadTypeClients_DisabledClientIsSent(t *testing.T) {
+	out, err := json.Marshal(Cluster{
+		WorkloadType: &WorkloadType{
+			Clients: &WorkloadTypeClients{
+				Jobs: true,
+			},
+		},
+	})
+	require.NoError(t, err)
+	assert.Contains(t, string(out), `"clients":{"notebooks":false,"jobs":true}`)
+}
@@ -0,0 +1,98 @@
+package clusters
+
+import (
+	"context"
+	"regexp"
+	"sort"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ClusterSummary is the subset of cluster attributes exposed by listing data sources
+type ClusterSummary struct {
+	ClusterID      string            `json:"cluster_id,omitempty"`
+	ClusterName    string            `json:"cluster_name,omitempty"`
+	State          string            `json:"state,omitempty"`
+	SparkVersion   string            `json:"spark_version,omitempty"`
+	NodeTypeID     string            `json:"node_type_id,omitempty"`
+	InstancePoolID string            `json:"instance_pool_id,omitempty"`
+	PolicyID       string            `json:"policy_id,omitempty"`
+	CustomTags     map[string]string `json:"custom_tags,omitempty"`
+}
+
+// MatchesTags returns true if every one of filter tags is present in tags with the same value
+func MatchesTags(tags, filter map[string]string) bool {
+	for k, v := range filter {
+		if actual, ok := tags[k]; !ok || actual != v {
+			return false
+		}
+	}
+	return true
+}
+
+// DataSourceClusters returns clusters matching name regex and custom tags
+func DataSourceClusters() *schema.Resource {
+	type clustersFilter struct {
+		NameRegex string            `json:"name_regex,omitempty"`
+		Tags      map[string]string `json:"tags,omitempty"`
+		IDs       []string          `json:"ids,omitempty" tf:"computed,slice_set"`
+		Clusters  []ClusterSummary  `json:"clusters,omitempty" tf:"computed"`
+	}
+	s := common.StructToSchema(clustersFilter{}, func(
+		s map[string]*schema.Schema) map[string]*schema.Schema {
+		// nolint once SDKv2 has Diagnostics-returning validators, change
+		s["name_regex"].ValidateFunc = validation.StringIsValidRegExp
+		return s
+	})
+	return &schema.Resource{
+		Schema: s,
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			var this clustersFilter
+			err := common.DataToStructPointer(d, s, &this)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			nameRegex, err := regexp.Compile(this.NameRegex)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			clusters, err := NewClustersAPI(ctx, m).List()
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			sort.Slice(clusters, func(i, j int) bool {
+				return clusters[i].ClusterID < clusters[j].ClusterID
+			})
+			this.IDs = []string{}
+			this.Clusters = []ClusterSummary{}
+			for _, c := range clusters {
+				if !nameRegex.MatchString(c.ClusterName) {
+					continue
+				}
+				if !MatchesTags(c.CustomTags, this.Tags) {
+					continue
+				}
+				this.IDs = append(this.IDs, c.ClusterID)
+				this.Clusters = append(this.Clusters, ClusterSummary{
+					ClusterID:      c.ClusterID,
+					ClusterName:    c.ClusterName,
+					State:          string(c.State),
+					SparkVersion:   c.SparkVersion,
+					NodeTypeID:     c.NodeTypeID,
+					InstancePoolID: c.InstancePoolID,
+					PolicyID:       c.PolicyID,
+					CustomTags:     c.CustomTags,
+				})
+			}
+			d.SetId("_")
+			err = common.StructToData(this, s, d)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,112 @@
+package clusters
+
+import (
+	"testing"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+	"github.com/databrickslabs/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchesTags(t *testing.T) {
+	tags := map[string]string{"team": "data", "env": "prod"}
+	assert.True(t, MatchesTags(tags, nil))
+	assert.True(t, MatchesTags(tags, map[string]string{"team": "data"}))
+	assert.False(t, MatchesTags(tags, map[string]string{"team": "ml"}))
+	assert.False(t, MatchesTags(nil, map[string]string{"owner": "x"}))
+}
+
+func TestDataSourceClusters(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/clusters/list",
+				Response: ClusterList{
+					Clusters: []ClusterInfo{
+						{
+							ClusterID:    "b",
+							ClusterName:  "shared-etl",
+							SparkVersion: "7.3.x-scala2.12",
+							State:        ClusterStateRunning,
+							CustomTags: map[string]string{
+								"team": "data",
+							},
+						},
+						{
+							ClusterID:   "a",
+							ClusterName: "shared-ml",
+							CustomTags: map[string]string{
+								"team": "ml",
+							},
+						},
+						{
+							ClusterID:   "c",
+							ClusterName: "personal",
+							CustomTags: map[string]string{
+								"team": "data",
+							},
+						},
+					},
+				},
+			},
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceClusters(),
+		ID:          ".",
+		HCL: `
+		name_regex = "^shared-"
+		tags = {
+			team = "data"
+		}`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "_", d.Id())
+	assert.Equal(t, 1, d.Get("ids.#"))
+	assert.Equal(t, "b", d.Get("clusters.0.cluster_id"))
+	assert.Equal(t, "shared-etl", d.Get("clusters.0.cluster_name"))
+	assert.Equal(t, "RUNNING", d.Get("clusters.0.state"))
+	assert.Equal(t, "7.3.x-scala2.12", d.Get("clusters.0.spark_version"))
+}
+
+func TestDataSourceClusters_NoFilter(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/clusters/list",
+				Response: ClusterList{
+					Clusters: []ClusterInfo{
+						{ClusterID: "b"},
+						{ClusterID: "a"},
+					},
+				},
+			},
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceClusters(),
+		ID:          ".",
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, 2, d.Get("ids.#"))
+	assert.Equal(t, "a", d.Get("clusters.0.cluster_id"))
+}
+
+func TestDataSourceClusters_Error(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/clusters/list",
+				Status:   404,
+				Response: common.NotFound("missing"),
+			},
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceClusters(),
+		ID:          ".",
+	}.ExpectError(t, "missing")
+}
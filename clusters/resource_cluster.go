@@ -2,7 +2,10 @@ package clusters
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -27,6 +30,13 @@ func ResourceCluster() *schema.Resource {
 			d *schema.ResourceData, c *common.DatabricksClient) error {
 			return NewClustersAPI(ctx, c).PermanentDelete(d.Id())
 		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, c interface{}) error {
+			var cluster Cluster
+			if err := common.DiffToStructPointer(d, clusterSchema, &cluster); err != nil {
+				return err
+			}
+			return cluster.validateDataSecurityMode(d.NewValueKnown("single_user_name"))
+		},
 		Schema:        clusterSchema,
 		SchemaVersion: 2,
 		Timeouts: &schema.ResourceTimeout{
@@ -74,6 +84,14 @@ func resourceClusterSchema() map[string]*schema.Schema {
 			p.Sensitive = true
 		}
 		s["autotermination_minutes"].Default = 60
+		for _, client := range []string{"notebooks", "jobs"} {
+			p, err := common.SchemaPath(s, "workload_type", "clients", client)
+			if err == nil {
+				p.Required = false
+				p.Optional = true
+				p.Default = true
+			}
+		}
 		s["cluster_id"] = &schema.Schema{
 			Type:     schema.TypeString,
 			Optional: true,
@@ -98,6 +116,11 @@ func resourceClusterSchema() map[string]*schema.Schema {
 				return old == new
 			},
 		}
+		s["uninstall_failed_libraries"] = &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		}
 		s["state"] = &schema.Schema{
 			Type:     schema.TypeString,
 			Computed: true,
@@ -116,6 +139,25 @@ func resourceClusterSchema() map[string]*schema.Schema {
 			Type:     schema.TypeString,
 			Computed: true,
 		}
+		s["data_security_mode"].ValidateFunc = validation.StringInSlice([]string{
+			DataSecurityModeNone,
+			DataSecurityModeSingleUser,
+			DataSecurityModeUserIsolation,
+			DataSecurityModeLegacyTableACL,
+			DataSecurityModeLegacyPassthrough,
+			DataSecurityModeLegacySingleUser,
+		}, false)
+		if v, err := common.SchemaPath(s, "gcp_attributes", "availability"); err == nil {
+			v.ValidateFunc = validation.StringInSlice([]string{
+				GcpAvailabilityPreemptible,
+				GcpAvailabilityOnDemand,
+				GcpAvailabilityPreemptibleWithFallback,
+			}, false)
+		}
+		s["runtime_engine"].ValidateFunc = validation.StringInSlice([]string{
+			RuntimeEngineStandard,
+			RuntimeEnginePhoton,
+		}, false)
 		return s
 	})
 }
@@ -156,9 +198,10 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, c *commo
 		// TODO: share the remainder of timeout from clusters.Create
 		timeout := d.Timeout(schema.TimeoutCreate)
 		_, err := libs.WaitForLibrariesInstalled(d.Id(), timeout)
-		if err != nil {
-			return err
+		if d.Get("uninstall_failed_libraries").(bool) {
+			return uninstallFailedLibraries(libs, d.Id(), err, libraryKeys(d.Get("library")))
 		}
+		return err
 	}
 	return nil
 }
@@ -196,17 +239,70 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, c *common.
 	d.Set("url", c.FormatURL("#setting/clusters/", d.Id(), "/configuration"))
 	librariesAPI := libraries.NewLibrariesAPI(ctx, c)
 	libsClusterStatus, err := librariesAPI.WaitForLibrariesInstalled(d.Id(), d.Timeout(schema.TimeoutRead))
+	var installErr libraries.LibraryInstallationError
+	if errors.As(err, &installErr) && d.Get("uninstall_failed_libraries").(bool) {
+		// failed libraries are uninstalled on create or update, so refresh just leaves them out
+		var cls libraries.ClusterLibraryStatuses
+		cls, err = librariesAPI.ClusterStatus(d.Id())
+		libsClusterStatus = &cls
+	}
 	if err != nil {
 		return err
 	}
-	libList := libsClusterStatus.ToLibraryList()
+	libList := libsClusterStatus.Active().ToLibraryList()
+	inState := libraryKeys(d.Get("library"))
+	if !d.IsNewResource() || len(inState) > 0 {
+		// libraries installed by databricks_library or outside of terraform
+		// are not managed by this resource, except on import
+		libList = libList.Only(inState)
+	}
+	if len(libList.Libraries) == 0 {
+		// empty lists are skipped by StructToData
+		return d.Set("library", nil)
+	}
 	return common.StructToData(libList, clusterSchema, d)
 }
 
+// uninstallFailedLibraries uninstalls libraries of this resource, that failed to install,
+// and reports them as warnings, so that they are installed again on the next apply
+func uninstallFailedLibraries(librariesAPI libraries.LibrariesAPI, clusterID string,
+	err error, managed map[string]bool) error {
+	uninstalled, err := librariesAPI.UninstallFailed(err, managed)
+	if err != nil {
+		return err
+	}
+	if len(uninstalled) == 0 {
+		return nil
+	}
+	warnings := common.Warnings{}
+	for _, status := range uninstalled {
+		messages := "no details"
+		if len(status.Messages) > 0 {
+			messages = strings.Join(status.Messages, ", ")
+		}
+		warnings = append(warnings, fmt.Sprintf("%s failed to install on cluster %s and was uninstalled: %s",
+			status.Library, clusterID, messages))
+	}
+	return warnings
+}
+
+// libraryKeys returns keys of libraries from `library` set
+func libraryKeys(raw interface{}) map[string]bool {
+	keys := map[string]bool{}
+	set, ok := raw.(*schema.Set)
+	if !ok {
+		return keys
+	}
+	for _, v := range set.List() {
+		keys[libraries.NewLibraryFromInstanceState(v).String()] = true
+	}
+	return keys
+}
+
 func hasClusterConfigChanged(d *schema.ResourceData) bool {
 	for k := range clusterSchema {
 		// TODO: create a map if we'll add more non-cluster config parameters in the future
-		if k == "library" || k == "is_pinned" {
+		if k == "library" || k == "is_pinned" || k == "uninstall_failed_libraries" {
 			continue
 		}
 		if d.HasChange(k) {
@@ -277,13 +373,24 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, c *commo
 	}
 	libraryList.ClusterID = clusterID
 	libsToInstall, libsToUninstall := libraryList.Diff(libsClusterStatus)
+	// uninstall only libraries previously managed by this resource
+	oldLibraries, _ := d.GetChange("library")
+	libsToUninstall = libsToUninstall.Only(libraryKeys(oldLibraries))
 	if len(libsToUninstall.Libraries) > 0 || len(libsToInstall.Libraries) > 0 {
 		if !clusterInfo.IsRunningOrResizing() {
 			if _, err = clusters.StartAndGetInfo(clusterID); err != nil {
 				return err
 			}
 		}
-		if err = librariesAPI.UpdateLibraries(clusterID, libsToInstall, libsToUninstall); err != nil {
+		err = librariesAPI.UpdateLibraries(clusterID, libsToInstall, libsToUninstall)
+		if d.Get("uninstall_failed_libraries").(bool) {
+			err = uninstallFailedLibraries(librariesAPI, clusterID, err, libraryKeys(d.Get("library")))
+		}
+		var warnings common.Warnings
+		if errors.As(err, &warnings) {
+			err = nil
+		}
+		if err != nil {
 			return err
 		}
 		if clusterInfo.State == ClusterStateTerminated {
@@ -292,6 +399,9 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, c *commo
 				return err
 			}
 		}
+		if len(warnings) > 0 {
+			return warnings
+		}
 	}
 	return nil
 }
@@ -1,6 +1,8 @@
 package clusters
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"testing"
@@ -9,6 +11,7 @@ import (
 	"github.com/databrickslabs/terraform-provider-databricks/libraries"
 
 	"github.com/databrickslabs/terraform-provider-databricks/qa"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -214,6 +217,13 @@ func TestResourceClusterCreate_WithLibraries(t *testing.T) {
 				ExpectedRequest: libraries.ClusterLibraryList{
 					ClusterID: "abc",
 					Libraries: []libraries.Library{
+						{
+							Maven: &libraries.Maven{
+								Coordinates: "foo:bar:baz:0.1.0",
+								Exclusions:  []string{"org.apache:flink:base"},
+								Repo:        "s3://maven-repo-in-s3/release",
+							},
+						},
 						{
 							Jar: "dbfs://foo.jar",
 						},
@@ -226,13 +236,6 @@ func TestResourceClusterCreate_WithLibraries(t *testing.T) {
 						{
 							Egg: "dbfs://bar.egg",
 						},
-						{
-							Maven: &libraries.Maven{
-								Coordinates: "foo:bar:baz:0.1.0",
-								Exclusions:  []string{"org.apache:flink:base"},
-								Repo:        "s3://maven-repo-in-s3/release",
-							},
-						},
 						{
 							Pypi: &libraries.PyPi{
 								Package: "seaborn==1.2.4",
@@ -772,20 +775,7 @@ func TestResourceClusterUpdate_LibrariesChangeOnTerminatedCluster(t *testing.T)
 					State:        ClusterStateRunning,
 				},
 			},
-			{
-				Method:   "POST",
-				Resource: "/api/2.0/libraries/uninstall",
-				ExpectedRequest: libraries.ClusterLibraryList{
-					ClusterID: "abc",
-					Libraries: []libraries.Library{
-						{
-							Pypi: &libraries.PyPi{
-								Package: "requests",
-							},
-						},
-					},
-				},
-			},
+			// requests library is not uninstalled, as it's not managed by this resource
 			{
 				Method:   "POST",
 				Resource: "/api/2.0/libraries/install",
@@ -830,6 +820,436 @@ func TestResourceClusterUpdate_LibrariesChangeOnTerminatedCluster(t *testing.T)
 	assert.Equal(t, "abc", d.Id(), "Id should be the same as in reading")
 }
 
+func TestResourceClusterUpdate_UninstallsOnlyManagedLibraries(t *testing.T) {
+	running := qa.HTTPFixture{
+		Method:       "GET",
+		Resource:     "/api/2.0/clusters/get?cluster_id=abc",
+		ReuseRequest: true,
+		Response: ClusterInfo{
+			ClusterID:              "abc",
+			NumWorkers:             100,
+			SparkVersion:           "7.1-scala12",
+			NodeTypeID:             "i3.xlarge",
+			AutoterminationMinutes: 60,
+			State:                  ClusterStateRunning,
+		},
+	}
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			running,
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/libraries/cluster-status?cluster_id=abc",
+				Response: libraries.ClusterLibraryStatuses{
+					ClusterID: "abc",
+					LibraryStatuses: []libraries.LibraryStatus{
+						{
+							Library: &libraries.Library{Jar: "dbfs://foo.jar"},
+							Status:  "INSTALLED",
+						},
+						{
+							// installed by databricks_library
+							Library: &libraries.Library{Egg: "dbfs://bar.egg"},
+							Status:  "INSTALLED",
+						},
+					},
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/libraries/uninstall",
+				ExpectedRequest: libraries.ClusterLibraryList{
+					ClusterID: "abc",
+					Libraries: []libraries.Library{
+						{Jar: "dbfs://foo.jar"},
+					},
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/libraries/install",
+				ExpectedRequest: libraries.ClusterLibraryList{
+					ClusterID: "abc",
+					Libraries: []libraries.Library{
+						{Whl: "dbfs://baz.whl"},
+					},
+				},
+			},
+			{
+				Method:       "GET",
+				Resource:     "/api/2.0/libraries/cluster-status?cluster_id=abc",
+				ReuseRequest: true,
+				Response: libraries.ClusterLibraryStatuses{
+					ClusterID: "abc",
+					LibraryStatuses: []libraries.LibraryStatus{
+						{
+							Library: &libraries.Library{Egg: "dbfs://bar.egg"},
+							Status:  "INSTALLED",
+						},
+						{
+							Library: &libraries.Library{Whl: "dbfs://baz.whl"},
+							Status:  "INSTALLED",
+						},
+					},
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/clusters/events",
+				ExpectedRequest: EventsRequest{
+					ClusterID:  "abc",
+					Limit:      1,
+					Order:      SortDescending,
+					EventTypes: []ClusterEventType{EvTypePinned, EvTypeUnpinned},
+				},
+				Response: EventsResponse{
+					Events:     []ClusterEvent{},
+					TotalCount: 0,
+				},
+			},
+		},
+		ID:     "abc",
+		Update: true,
+		InstanceState: map[string]string{
+			"autotermination_minutes": "60",
+			"spark_version":           "7.1-scala12",
+			"node_type_id":            "i3.xlarge",
+			"num_workers":             "100",
+			"library.#":               "1",
+			"library.18895335.jar":    "dbfs://foo.jar",
+		},
+		Resource: ResourceCluster(),
+		HCL: `num_workers = 100
+		spark_version = "7.1-scala12"
+		node_type_id = "i3.xlarge"
+
+		library {
+			whl = "dbfs://baz.whl"
+		}`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, 1, d.Get("library.#"))
+}
+
+func TestResourceClusterRead_IgnoresUnmanagedLibraries(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/clusters/get?cluster_id=abc",
+				Response: ClusterInfo{
+					ClusterID:              "abc",
+					NumWorkers:             100,
+					SparkVersion:           "7.1-scala12",
+					NodeTypeID:             "i3.xlarge",
+					AutoterminationMinutes: 60,
+					State:                  ClusterStateRunning,
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/clusters/events",
+				ExpectedRequest: EventsRequest{
+					ClusterID:  "abc",
+					Limit:      1,
+					Order:      SortDescending,
+					EventTypes: []ClusterEventType{EvTypePinned, EvTypeUnpinned},
+				},
+				Response: EventsResponse{
+					Events:     []ClusterEvent{},
+					TotalCount: 0,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/libraries/cluster-status?cluster_id=abc",
+				Response: libraries.ClusterLibraryStatuses{
+					ClusterID: "abc",
+					LibraryStatuses: []libraries.LibraryStatus{
+						{
+							Library: &libraries.Library{Jar: "dbfs://foo.jar"},
+							Status:  "INSTALLED",
+						},
+						{
+							Library: &libraries.Library{Egg: "dbfs://bar.egg"},
+							Status:  "INSTALLED",
+						},
+					},
+				},
+			},
+		},
+		ID:       "abc",
+		Read:     true,
+		Resource: ResourceCluster(),
+		State: map[string]interface{}{
+			"autotermination_minutes": 60,
+			"spark_version":           "7.1-scala12",
+			"node_type_id":            "i3.xlarge",
+			"num_workers":             100,
+			"library": []interface{}{
+				map[string]interface{}{
+					"jar": "dbfs://foo.jar",
+				},
+			},
+		},
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, 1, d.Get("library.#"))
+}
+
+func clusterWithFailedLibraryFixtures(afterFailure ...qa.HTTPFixture) []qa.HTTPFixture {
+	fixtures := []qa.HTTPFixture{
+		{
+			Method:   "POST",
+			Resource: "/api/2.0/clusters/create",
+			ExpectedRequest: Cluster{
+				NumWorkers:             100,
+				SparkVersion:           "7.1-scala12",
+				NodeTypeID:             "i3.xlarge",
+				AutoterminationMinutes: 60,
+			},
+			Response: ClusterInfo{
+				ClusterID: "abc",
+				State:     ClusterStateRunning,
+			},
+		},
+		{
+			Method:       "GET",
+			ReuseRequest: true,
+			Resource:     "/api/2.0/clusters/get?cluster_id=abc",
+			Response: ClusterInfo{
+				ClusterID:              "abc",
+				NumWorkers:             100,
+				SparkVersion:           "7.1-scala12",
+				NodeTypeID:             "i3.xlarge",
+				AutoterminationMinutes: 60,
+				State:                  ClusterStateRunning,
+			},
+		},
+		{
+			Method:   "POST",
+			Resource: "/api/2.0/libraries/install",
+			ExpectedRequest: libraries.ClusterLibraryList{
+				ClusterID: "abc",
+				Libraries: []libraries.Library{
+					{
+						Pypi: &libraries.PyPi{
+							Package: "seaborn==0.0.0",
+						},
+					},
+				},
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/libraries/cluster-status?cluster_id=abc",
+			Response: libraries.ClusterLibraryStatuses{
+				ClusterID: "abc",
+				LibraryStatuses: []libraries.LibraryStatus{
+					{
+						Library: &libraries.Library{
+							Pypi: &libraries.PyPi{
+								Package: "seaborn==0.0.0",
+							},
+						},
+						Status:   "FAILED",
+						Messages: []string{"No matching distribution found"},
+					},
+				},
+			},
+		},
+	}
+	return append(fixtures, afterFailure...)
+}
+
+func TestResourceClusterCreate_FailedLibrary(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: clusterWithFailedLibraryFixtures(),
+		Create:   true,
+		Resource: ResourceCluster(),
+		HCL: `num_workers = 100
+		spark_version = "7.1-scala12"
+		node_type_id = "i3.xlarge"
+		library {
+			pypi {
+				package = "seaborn==0.0.0"
+			}
+		}`,
+	}.ExpectError(t, "pypi:seaborn==0.0.0 failed: No matching distribution found")
+}
+
+func TestResourceClusterCreate_UninstallFailedLibraries(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: clusterWithFailedLibraryFixtures(
+			qa.HTTPFixture{
+				Method:   "POST",
+				Resource: "/api/2.0/libraries/uninstall",
+				ExpectedRequest: libraries.ClusterLibraryList{
+					ClusterID: "abc",
+					Libraries: []libraries.Library{
+						{
+							Pypi: &libraries.PyPi{
+								Package: "seaborn==0.0.0",
+							},
+						},
+					},
+				},
+			},
+			qa.HTTPFixture{
+				Method:       "GET",
+				Resource:     "/api/2.0/libraries/cluster-status?cluster_id=abc",
+				ReuseRequest: true,
+				Response: libraries.ClusterLibraryStatuses{
+					ClusterID: "abc",
+					LibraryStatuses: []libraries.LibraryStatus{
+						{
+							Library: &libraries.Library{
+								Pypi: &libraries.PyPi{
+									Package: "seaborn==0.0.0",
+								},
+							},
+							Status: "UNINSTALL_ON_RESTART",
+						},
+					},
+				},
+			},
+			qa.HTTPFixture{
+				Method:   "POST",
+				Resource: "/api/2.0/clusters/events",
+				ExpectedRequest: EventsRequest{
+					ClusterID:  "abc",
+					Limit:      1,
+					Order:      SortDescending,
+					EventTypes: []ClusterEventType{EvTypePinned, EvTypeUnpinned},
+				},
+				Response: EventsResponse{
+					Events:     []ClusterEvent{},
+					TotalCount: 0,
+				},
+			}),
+		Create:   true,
+		Resource: ResourceCluster(),
+		HCL: `num_workers = 100
+		spark_version = "7.1-scala12"
+		node_type_id = "i3.xlarge"
+		uninstall_failed_libraries = true
+		library {
+			pypi {
+				package = "seaborn==0.0.0"
+			}
+		}`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "abc", d.Id())
+	assert.Equal(t, 0, d.Get("library.#"))
+}
+
+func TestResourceClusterRead_FailedLibrariesAreNotUninstalled(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/clusters/get?cluster_id=abc",
+				Response: ClusterInfo{
+					ClusterID:              "abc",
+					NumWorkers:             100,
+					SparkVersion:           "7.1-scala12",
+					NodeTypeID:             "i3.xlarge",
+					AutoterminationMinutes: 60,
+					State:                  ClusterStateRunning,
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/clusters/events",
+				Response: EventsResponse{
+					Events: []ClusterEvent{},
+				},
+			},
+			{
+				Method:       "GET",
+				Resource:     "/api/2.0/libraries/cluster-status?cluster_id=abc",
+				ReuseRequest: true,
+				Response: libraries.ClusterLibraryStatuses{
+					ClusterID: "abc",
+					LibraryStatuses: []libraries.LibraryStatus{
+						{
+							Library: &libraries.Library{
+								Whl: "a.whl",
+							},
+							Status: "INSTALLED",
+						},
+						{
+							Library: &libraries.Library{
+								Pypi: &libraries.PyPi{
+									Package: "seaborn==0.0.0",
+								},
+							},
+							Status:   "FAILED",
+							Messages: []string{"No matching distribution found"},
+						},
+					},
+				},
+			},
+		},
+		Read:     true,
+		ID:       "abc",
+		Resource: ResourceCluster(),
+		HCL: `num_workers = 100
+		spark_version = "7.1-scala12"
+		node_type_id = "i3.xlarge"
+		uninstall_failed_libraries = true
+		library {
+			whl = "a.whl"
+		}
+		library {
+			pypi {
+				package = "seaborn==0.0.0"
+			}
+		}`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, 1, d.Get("library.#"))
+}
+
+func TestUninstallFailedLibrariesWarnings(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "POST",
+			Resource: "/api/2.0/libraries/uninstall",
+			ExpectedRequest: libraries.ClusterLibraryList{
+				ClusterID: "abc",
+				Libraries: []libraries.Library{
+					{
+						Whl: "a.whl",
+					},
+				},
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		err := uninstallFailedLibraries(libraries.NewLibrariesAPI(ctx, client), "abc",
+			libraries.LibraryInstallationError{
+				ClusterID: "abc",
+				Failed: []libraries.LibraryStatus{
+					{
+						Library:  &libraries.Library{Whl: "a.whl"},
+						Status:   "FAILED",
+						Messages: []string{"bad wheel"},
+					},
+					{
+						Library: &libraries.Library{Jar: "b.jar"},
+						Status:  "FAILED",
+					},
+				},
+			}, map[string]bool{"whl:a.whl": true})
+		var warnings common.Warnings
+		require.True(t, errors.As(err, &warnings))
+		assert.Equal(t, common.Warnings{
+			"whl:a.whl failed to install on cluster abc and was uninstalled: bad wheel",
+		}, warnings)
+	})
+}
+
 func TestResourceClusterUpdate_Error(t *testing.T) {
 	d, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
@@ -1111,6 +1531,172 @@ func TestResourceClusterCreate_SingleNodeFail(t *testing.T) {
 	require.Equal(t, true, strings.Contains(err.Error(), "NumWorkers could be 0 only for SingleNode clusters"))
 }
 
+func TestResourceClusterCreate_DataSecurityMode(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/clusters/create",
+				ExpectedRequest: Cluster{
+					NumWorkers:             1,
+					ClusterName:            "Isolated",
+					SparkVersion:           "10.1.x-photon-scala2.12",
+					NodeTypeID:             "i3.xlarge",
+					AutoterminationMinutes: 60,
+					SingleUserName:         "me@example.com",
+					DataSecurityMode:       DataSecurityModeSingleUser,
+					RuntimeEngine:          RuntimeEnginePhoton,
+					WorkloadType: &WorkloadType{
+						Clients: &WorkloadTypeClients{
+							Jobs: true,
+						},
+					},
+					ClusterMountInfos: []MountInfo{
+						{
+							NetworkFilesystemInfo: &NetworkFileSystemInfo{
+								ServerAddress: "10.0.0.1",
+							},
+							RemoteMountDirPath: "/export",
+							LocalMountDirPath:  "/mnt/nfs",
+						},
+					},
+				},
+				Response: ClusterInfo{
+					ClusterID: "abc",
+					State:     ClusterStateRunning,
+				},
+			},
+			{
+				Method:       "GET",
+				ReuseRequest: true,
+				Resource:     "/api/2.0/clusters/get?cluster_id=abc",
+				Response: ClusterInfo{
+					ClusterID:              "abc",
+					NumWorkers:             1,
+					ClusterName:            "Isolated",
+					SparkVersion:           "10.1.x-photon-scala2.12",
+					NodeTypeID:             "i3.xlarge",
+					AutoterminationMinutes: 60,
+					SingleUserName:         "me@example.com",
+					DataSecurityMode:       DataSecurityModeSingleUser,
+					RuntimeEngine:          RuntimeEnginePhoton,
+					WorkloadType: &WorkloadType{
+						Clients: &WorkloadTypeClients{
+							Jobs: true,
+						},
+					},
+					ClusterMountInfos: []MountInfo{
+						{
+							NetworkFilesystemInfo: &NetworkFileSystemInfo{
+								ServerAddress: "10.0.0.1",
+							},
+							RemoteMountDirPath: "/export",
+							LocalMountDirPath:  "/mnt/nfs",
+						},
+					},
+					State: ClusterStateRunning,
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/clusters/events",
+				ExpectedRequest: EventsRequest{
+					ClusterID:  "abc",
+					Limit:      1,
+					Order:      SortDescending,
+					EventTypes: []ClusterEventType{EvTypePinned, EvTypeUnpinned},
+				},
+				Response: EventsResponse{
+					Events:     []ClusterEvent{},
+					TotalCount: 0,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/libraries/cluster-status?cluster_id=abc",
+				Response: libraries.ClusterLibraryStatuses{
+					LibraryStatuses: []libraries.LibraryStatus{},
+				},
+			},
+		},
+		Create:   true,
+		Resource: ResourceCluster(),
+		HCL: `
+		cluster_name = "Isolated"
+		spark_version = "10.1.x-photon-scala2.12"
+		node_type_id = "i3.xlarge"
+		num_workers = 1
+		single_user_name = "me@example.com"
+		data_security_mode = "SINGLE_USER"
+		runtime_engine = "PHOTON"
+		workload_type {
+			clients {
+				jobs = true
+				notebooks = false
+			}
+		}
+		cluster_mount_info {
+			network_filesystem_info {
+				server_address = "10.0.0.1"
+			}
+			remote_mount_dir_path = "/export"
+			local_mount_dir_path = "/mnt/nfs"
+		}`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "abc", d.Id())
+	assert.Equal(t, "SINGLE_USER", d.Get("data_security_mode"))
+	assert.Equal(t, "PHOTON", d.Get("runtime_engine"))
+	assert.Equal(t, true, d.Get("workload_type.0.clients.0.jobs"))
+	assert.Equal(t, false, d.Get("workload_type.0.clients.0.notebooks"))
+	assert.Equal(t, "/mnt/nfs", d.Get("cluster_mount_info.0.local_mount_dir_path"))
+}
+
+func TestResourceClusterCreate_SingleUserWithoutName(t *testing.T) {
+	qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourceCluster(),
+		HCL: `
+		cluster_name = "Isolated"
+		spark_version = "10.1.x-scala2.12"
+		node_type_id = "i3.xlarge"
+		num_workers = 1
+		data_security_mode = "SINGLE_USER"`,
+	}.ExpectError(t, "data_security_mode SINGLE_USER requires single_user_name")
+}
+
+func TestResourceClusterDiff_SingleUserNameNotYetKnown(t *testing.T) {
+	r := ResourceCluster()
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"cluster_name":       "Isolated",
+		"spark_version":      "10.1.x-scala2.12",
+		"node_type_id":       "i3.xlarge",
+		"num_workers":        1,
+		"data_security_mode": "SINGLE_USER",
+		// interpolated from a user, that is not yet created
+		"single_user_name": "74D93920-ED26-11E3-AC10-0800200C9A66",
+	})
+	diff, err := r.Diff(context.Background(), &terraform.InstanceState{}, config, nil)
+	assert.NoError(t, err)
+	assert.True(t, diff.Attributes["single_user_name"].NewComputed)
+}
+
+func TestResourceClusterCreate_UserIsolationWithDocker(t *testing.T) {
+	qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourceCluster(),
+		HCL: `
+		cluster_name = "Shared"
+		spark_version = "10.1.x-scala2.12"
+		node_type_id = "i3.xlarge"
+		num_workers = 1
+		data_security_mode = "USER_ISOLATION"
+		docker_image {
+			url = "databricksruntime/standard:latest"
+		}`,
+	}.ExpectError(t, "docker_image cannot be used with data_security_mode USER_ISOLATION")
+}
+
 func TestResourceClusterCreate_NegativeNumWorkers(t *testing.T) {
 	_, err := qa.ResourceFixture{
 		Create:   true,
@@ -1191,13 +1777,20 @@ func TestModifyClusterRequestGcp(t *testing.T) {
 		InstancePoolID: "a",
 		GcpAttributes: &GcpAttributes{
 			UsePreemptibleExecutors: true,
+			GoogleServiceAccount:    "sa@prj.iam.gserviceaccount.com",
+			Availability:            GcpAvailabilityPreemptible,
+			BootDiskSize:            100,
+			LocalSsdCount:           2,
+			ZoneID:                  "us-central1-a",
 		},
 		EnableElasticDisk: true,
 		NodeTypeID:        "d",
 		DriverNodeTypeID:  "e",
 	}
 	c.ModifyRequestOnInstancePool()
-	assert.Equal(t, false, c.GcpAttributes.UsePreemptibleExecutors)
+	assert.Equal(t, GcpAttributes{
+		GoogleServiceAccount: "sa@prj.iam.gserviceaccount.com",
+	}, *c.GcpAttributes)
 	assert.Equal(t, "", c.NodeTypeID)
 	assert.Equal(t, "", c.DriverNodeTypeID)
 	assert.Equal(t, false, c.EnableElasticDisk)
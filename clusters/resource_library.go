@@ -0,0 +1,116 @@
+package clusters
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+	"github.com/databrickslabs/terraform-provider-databricks/libraries"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// libraryFromID splits resource ID into cluster ID and library coordinates,
+// as they are formatted by libraries.Library String()
+func libraryFromID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid ID: %s", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+// ResourceLibrary manages a single library on a cluster, so that different teams
+// can own libraries on shared clusters independently from the cluster definition
+func ResourceLibrary() *schema.Resource {
+	s := common.StructToSchema(libraries.Library{}, func(
+		s map[string]*schema.Schema) map[string]*schema.Schema {
+		s["cluster_id"] = &schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+		}
+		return s
+	})
+	return common.Resource{
+		Schema: s,
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			clusterID := d.Get("cluster_id").(string)
+			var lib libraries.Library
+			if err := common.DataToStructPointer(d, s, &lib); err != nil {
+				return err
+			}
+			clusterInfo, err := NewClustersAPI(ctx, c).Get(clusterID)
+			if err != nil {
+				return err
+			}
+			librariesAPI := libraries.NewLibrariesAPI(ctx, c)
+			err = librariesAPI.Install(libraries.ClusterLibraryList{
+				ClusterID: clusterID,
+				Libraries: []libraries.Library{lib},
+			})
+			if err != nil {
+				return err
+			}
+			d.SetId(fmt.Sprintf("%s/%s", clusterID, lib))
+			if !clusterInfo.IsRunningOrResizing() {
+				// libraries are installed on terminated clusters once they start
+				log.Printf("[INFO] Cluster %s is %s, so %s will be installed on next start",
+					clusterID, clusterInfo.State, lib)
+				return nil
+			}
+			_, err = librariesAPI.WaitForLibrariesInstalled(clusterID, d.Timeout(schema.TimeoutCreate))
+			var installErr libraries.LibraryInstallationError
+			if !errors.As(err, &installErr) {
+				return err
+			}
+			// failures of libraries managed elsewhere must not block this one
+			for _, status := range installErr.Failed {
+				if status.Library.String() == lib.String() {
+					return libraries.LibraryInstallationError{
+						ClusterID: clusterID,
+						Failed:    []libraries.LibraryStatus{status},
+					}
+				}
+				log.Printf("[WARN] %s failed to install on cluster %s: %s",
+					status.Library, clusterID, strings.Join(status.Messages, ", "))
+			}
+			return nil
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			clusterID, libraryKey, err := libraryFromID(d.Id())
+			if err != nil {
+				return err
+			}
+			cls, err := libraries.NewLibrariesAPI(ctx, c).ClusterStatus(clusterID)
+			if err != nil {
+				return err
+			}
+			for _, status := range cls.LibraryStatuses {
+				if status.Library == nil || status.Library.String() != libraryKey {
+					continue
+				}
+				if status.Status == "UNINSTALL_ON_RESTART" {
+					break
+				}
+				d.Set("cluster_id", clusterID)
+				return common.StructToData(*status.Library, s, d)
+			}
+			return common.NotFound(fmt.Sprintf("library %s is not installed on cluster %s",
+				libraryKey, clusterID))
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			clusterID := d.Get("cluster_id").(string)
+			var lib libraries.Library
+			if err := common.DataToStructPointer(d, s, &lib); err != nil {
+				return err
+			}
+			// library is removed from the cluster after its restart
+			return libraries.NewLibrariesAPI(ctx, c).Uninstall(libraries.ClusterLibraryList{
+				ClusterID: clusterID,
+				Libraries: []libraries.Library{lib},
+			})
+		},
+	}.ToResource()
+}
@@ -0,0 +1,306 @@
+package clusters
+
+import (
+	"testing"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+	"github.com/databrickslabs/terraform-provider-databricks/libraries"
+	"github.com/databrickslabs/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceLibraryCreate_RunningCluster(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/clusters/get?cluster_id=abc",
+				Response: ClusterInfo{
+					ClusterID: "abc",
+					State:     ClusterStateRunning,
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/libraries/install",
+				ExpectedRequest: libraries.ClusterLibraryList{
+					ClusterID: "abc",
+					Libraries: []libraries.Library{
+						{
+							Pypi: &libraries.PyPi{
+								Package: "seaborn==1.2.4",
+							},
+						},
+					},
+				},
+			},
+			{
+				Method:       "GET",
+				Resource:     "/api/2.0/libraries/cluster-status?cluster_id=abc",
+				ReuseRequest: true,
+				Response: libraries.ClusterLibraryStatuses{
+					ClusterID: "abc",
+					LibraryStatuses: []libraries.LibraryStatus{
+						{
+							Library: &libraries.Library{
+								Pypi: &libraries.PyPi{
+									Package: "seaborn==1.2.4",
+								},
+							},
+							Status: "INSTALLED",
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceLibrary(),
+		Create:   true,
+		HCL: `cluster_id = "abc"
+		pypi {
+			package = "seaborn==1.2.4"
+		}`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "abc/pypi:seaborn==1.2.4", d.Id())
+	assert.Equal(t, "seaborn==1.2.4", d.Get("pypi.0.package"))
+}
+
+func TestResourceLibraryCreate_TerminatedCluster(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/clusters/get?cluster_id=abc",
+				Response: ClusterInfo{
+					ClusterID: "abc",
+					State:     ClusterStateTerminated,
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/libraries/install",
+				ExpectedRequest: libraries.ClusterLibraryList{
+					ClusterID: "abc",
+					Libraries: []libraries.Library{
+						{
+							Jar: "dbfs:/FileStore/foo.jar",
+						},
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/libraries/cluster-status?cluster_id=abc",
+				Response: libraries.ClusterLibraryStatuses{
+					ClusterID: "abc",
+					LibraryStatuses: []libraries.LibraryStatus{
+						{
+							Library: &libraries.Library{
+								Jar: "dbfs:/FileStore/foo.jar",
+							},
+							Status: "PENDING",
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceLibrary(),
+		Create:   true,
+		HCL: `cluster_id = "abc"
+		jar = "dbfs:/FileStore/foo.jar"`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "abc/jar:dbfs:/FileStore/foo.jar", d.Id())
+}
+
+func TestResourceLibraryCreate_Error(t *testing.T) {
+	_, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/clusters/get?cluster_id=abc",
+				Response: ClusterInfo{
+					ClusterID: "abc",
+					State:     ClusterStateRunning,
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/libraries/install",
+				Response: common.APIErrorBody{
+					ErrorCode: "INVALID_REQUEST",
+					Message:   "Invalid library",
+				},
+				Status: 400,
+			},
+		},
+		Resource: ResourceLibrary(),
+		Create:   true,
+		HCL: `cluster_id = "abc"
+		whl = "dbfs:/FileStore/baz.whl"`,
+	}.Apply(t)
+	qa.AssertErrorStartsWith(t, err, "Invalid library")
+}
+
+func TestResourceLibraryRead_Import(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/libraries/cluster-status?cluster_id=abc",
+				Response: libraries.ClusterLibraryStatuses{
+					ClusterID: "abc",
+					LibraryStatuses: []libraries.LibraryStatus{
+						{
+							Library: &libraries.Library{
+								Jar: "dbfs:/FileStore/foo.jar",
+							},
+							Status: "INSTALLED",
+						},
+						{
+							Library: &libraries.Library{
+								Maven: &libraries.Maven{
+									Coordinates: "com.microsoft.azure:azure-eventhubs-spark_2.12:2.3.18",
+									Exclusions:  []string{"org.apache:flink:base"},
+								},
+							},
+							Status: "INSTALLED",
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceLibrary(),
+		Read:     true,
+		New:      true,
+		ID: "abc/mvn:com.microsoft.azure:azure-eventhubs-spark_2.12:2.3.18" +
+			"org.apache:flink:base",
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "abc", d.Get("cluster_id"))
+	assert.Equal(t, "com.microsoft.azure:azure-eventhubs-spark_2.12:2.3.18",
+		d.Get("maven.0.coordinates"))
+	assert.Equal(t, "org.apache:flink:base", d.Get("maven.0.exclusions.0"))
+}
+
+func TestResourceLibraryRead_UninstallOnRestart(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/libraries/cluster-status?cluster_id=abc",
+				Response: libraries.ClusterLibraryStatuses{
+					ClusterID: "abc",
+					LibraryStatuses: []libraries.LibraryStatus{
+						{
+							Library: &libraries.Library{
+								Jar: "dbfs:/FileStore/foo.jar",
+							},
+							Status: "UNINSTALL_ON_RESTART",
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceLibrary(),
+		Read:     true,
+		Removed:  true,
+		ID:       "abc/jar:dbfs:/FileStore/foo.jar",
+	}.ApplyNoError(t)
+}
+
+func TestResourceLibraryRead_InvalidID(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceLibrary(),
+		Read:     true,
+		ID:       "abc",
+	}.ExpectError(t, "invalid ID: abc")
+}
+
+func TestResourceLibraryDelete(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/libraries/uninstall",
+				ExpectedRequest: libraries.ClusterLibraryList{
+					ClusterID: "abc",
+					Libraries: []libraries.Library{
+						{
+							Cran: &libraries.Cran{
+								Package: "rkeops",
+							},
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceLibrary(),
+		Delete:   true,
+		ID:       "abc/cran:rkeops",
+		HCL: `cluster_id = "abc"
+		cran {
+			package = "rkeops"
+		}`,
+	}.ApplyNoError(t)
+}
+
+func TestResourceLibraryCreate_OtherLibraryFailed(t *testing.T) {
+	statuses := []libraries.LibraryStatus{
+		{
+			Library: &libraries.Library{
+				Whl: "dbfs:/FileStore/baz.whl",
+			},
+			Status: "INSTALLED",
+		},
+		{
+			Library: &libraries.Library{
+				Jar: "dbfs:/FileStore/broken.jar",
+			},
+			Status:   "FAILED",
+			Messages: []string{"Library is corrupt"},
+		},
+	}
+	fixtures := func() []qa.HTTPFixture {
+		return []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/clusters/get?cluster_id=abc",
+				Response: ClusterInfo{
+					ClusterID: "abc",
+					State:     ClusterStateRunning,
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/libraries/install",
+			},
+			{
+				Method:       "GET",
+				Resource:     "/api/2.0/libraries/cluster-status?cluster_id=abc",
+				ReuseRequest: true,
+				Response: libraries.ClusterLibraryStatuses{
+					ClusterID:       "abc",
+					LibraryStatuses: statuses,
+				},
+			},
+		}
+	}
+	d, err := qa.ResourceFixture{
+		Fixtures: fixtures(),
+		Resource: ResourceLibrary(),
+		Create:   true,
+		HCL: `cluster_id = "abc"
+		whl = "dbfs:/FileStore/baz.whl"`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "abc/whl:dbfs:/FileStore/baz.whl", d.Id())
+
+	qa.ResourceFixture{
+		Fixtures: fixtures(),
+		Resource: ResourceLibrary(),
+		Create:   true,
+		HCL: `cluster_id = "abc"
+		jar = "dbfs:/FileStore/broken.jar"`,
+	}.ExpectError(t, "jar:dbfs:/FileStore/broken.jar failed: Library is corrupt")
+}
@@ -22,9 +22,11 @@ type Command struct {
 
 // NewCommandsAPI creates CommandsAPI instance from provider meta
 func NewCommandsAPI(ctx context.Context, m interface{}) CommandsAPI {
+	client := m.(*common.DatabricksClient)
 	return CommandsAPI{
-		client:  m.(*common.DatabricksClient),
+		client:  client,
 		context: context.WithValue(ctx, common.Api, common.API_1_2),
+		pool:    poolFor(client),
 	}
 }
 
@@ -32,10 +34,14 @@ func NewCommandsAPI(ctx context.Context, m interface{}) CommandsAPI {
 type CommandsAPI struct {
 	client  *common.DatabricksClient
 	context context.Context
+	pool    *contextPool
 }
 
-// Execute creates a spark context and executes a command and then closes context
-// Any leading whitespace is trimmed
+// Execute takes an execution context from the pool or creates a new one, executes a command and
+// returns the context back to the pool, so that it's reused by the next command on the same cluster.
+// Contexts of failed commands are destroyed instead.
+// Interpreter state is not reset between commands: variables, imports and temporary views, defined
+// by one command, remain visible to the next commands in the same context. Any leading whitespace is trimmed
 func (a CommandsAPI) Execute(clusterID, language, commandStr string) common.CommandResults {
 	// this is the place, where API version propagation through context looks strange
 	ctx := context.WithValue(a.context, common.Api, common.API_2_0)
@@ -54,57 +60,78 @@ func (a CommandsAPI) Execute(clusterID, language, commandStr string) common.Comm
 	}
 	commandStr = internal.TrimLeadingWhitespace(commandStr)
 	log.Printf("[INFO] Executing %s command on %s:\n%s", language, clusterID, commandStr)
-	context, err := a.createContext(language, clusterID)
+	key := contextKey{clusterID, language}
+	contextID, err := a.acquireContext(key)
 	if err != nil {
 		return common.CommandResults{
 			ResultType: "error",
 			Summary:    err.Error(),
 		}
 	}
-	err = a.waitForContextReady(context, clusterID)
+	command, err := a.runCommand(contextID, clusterID, language, commandStr)
 	if err != nil {
+		a.pool.discard(key, contextID)
 		return common.CommandResults{
 			ResultType: "error",
 			Summary:    err.Error(),
 		}
 	}
-	commandID, err := a.createCommand(context, clusterID, language, commandStr)
-	if err != nil {
+	if command.Results == nil {
+		a.pool.discard(key, contextID)
+		log.Printf("[ERROR] Command has no results: %#v", command)
 		return common.CommandResults{
 			ResultType: "error",
-			Summary:    err.Error(),
+			Summary:    "Command has no results",
 		}
 	}
-	// TODO: merge getCommand and waitForCommandFinished to "waitForCommandResults"
-	err = a.waitForCommandFinished(commandID, context, clusterID)
-	if err != nil {
-		return common.CommandResults{
-			ResultType: "error",
-			Summary:    err.Error(),
+	if command.Results.Failed() {
+		// interpreter state might be left half-defined by the failed command
+		a.pool.discard(key, contextID)
+	} else {
+		a.pool.put(key, contextID)
+	}
+	return *command.Results
+}
+
+// acquireContext returns healthy idle context from the pool or creates a new one
+func (a CommandsAPI) acquireContext(key contextKey) (string, error) {
+	for {
+		contextID, ok := a.pool.take(key)
+		if !ok {
+			break
 		}
+		status, err := a.getContext(contextID, key.clusterID)
+		if err == nil && status == "Running" {
+			log.Printf("[DEBUG] Reusing execution context %s on %s", contextID, key.clusterID)
+			return contextID, nil
+		}
+		log.Printf("[DEBUG] Execution context %s on %s is not healthy: %s %v",
+			contextID, key.clusterID, status, err)
+		a.pool.discard(key, contextID)
 	}
-	command, err := a.getCommand(commandID, context, clusterID)
+	contextID, err := a.createContext(key.language, key.clusterID)
 	if err != nil {
-		return common.CommandResults{
-			ResultType: "error",
-			Summary:    err.Error(),
-		}
+		return "", err
 	}
-	err = a.deleteContext(context, clusterID)
+	err = a.waitForContextReady(contextID, key.clusterID)
 	if err != nil {
-		return common.CommandResults{
-			ResultType: "error",
-			Summary:    err.Error(),
-		}
+		a.pool.discard(key, contextID)
+		return "", err
 	}
-	if command.Results == nil {
-		log.Printf("[ERROR] Command has no results: %#v", command)
-		return common.CommandResults{
-			ResultType: "error",
-			Summary:    "Command has no results",
-		}
+	return contextID, nil
+}
+
+func (a CommandsAPI) runCommand(contextID, clusterID, language, commandStr string) (Command, error) {
+	commandID, err := a.createCommand(contextID, clusterID, language, commandStr)
+	if err != nil {
+		return Command{}, err
 	}
-	return *command.Results
+	// TODO: merge getCommand and waitForCommandFinished to "waitForCommandResults"
+	err = a.waitForCommandFinished(commandID, contextID, clusterID)
+	if err != nil {
+		return Command{}, err
+	}
+	return a.getCommand(commandID, contextID, clusterID)
 }
 
 type genericCommandRequest struct {
@@ -269,6 +269,14 @@ func TestCommandsAPIExecute_FailToWaitForContext(t *testing.T) {
 				Message: "Does not compute",
 			},
 		},
+		{
+			Method:   "POST",
+			Resource: "/api/1.2/contexts/destroy",
+			ExpectedRequest: genericCommandRequest{
+				ClusterID: "abc",
+				ContextID: "abc",
+			},
+		},
 	}, func(ctx context.Context, client *common.DatabricksClient) {
 		commands := NewCommandsAPI(ctx, client)
 		cr := commands.Execute("abc", "cobol", "Hello?")
@@ -307,6 +315,14 @@ func TestCommandsAPIExecute_FailToCreateCommand(t *testing.T) {
 				Message: "Does not compute",
 			},
 		},
+		{
+			Method:   "POST",
+			Resource: "/api/1.2/contexts/destroy",
+			ExpectedRequest: genericCommandRequest{
+				ClusterID: "abc",
+				ContextID: "abc",
+			},
+		},
 	}, func(ctx context.Context, client *common.DatabricksClient) {
 		commands := NewCommandsAPI(ctx, client)
 		cr := commands.Execute("abc", "cobol", "Hello?")
@@ -352,6 +368,14 @@ func TestCommandsAPIExecute_FailToWaitForCommand(t *testing.T) {
 				Message: "Does not compute",
 			},
 		},
+		{
+			Method:   "POST",
+			Resource: "/api/1.2/contexts/destroy",
+			ExpectedRequest: genericCommandRequest{
+				ClusterID: "abc",
+				ContextID: "abc",
+			},
+		},
 	}, func(ctx context.Context, client *common.DatabricksClient) {
 		commands := NewCommandsAPI(ctx, client)
 		cr := commands.Execute("abc", "cobol", "Hello?")
@@ -404,57 +428,12 @@ func TestCommandsAPIExecute_FailToGetCommand(t *testing.T) {
 				Message: "Does not compute",
 			},
 		},
-	}, func(ctx context.Context, client *common.DatabricksClient) {
-		commands := NewCommandsAPI(ctx, client)
-		cr := commands.Execute("abc", "cobol", "Hello?")
-		assert.EqualError(t, cr.Err(), "Does not compute")
-	})
-}
-
-func TestCommandsAPIExecute_FailToDeleteContext(t *testing.T) {
-	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
-		{
-			Method:   "GET",
-			Resource: "/api/2.0/clusters/get?cluster_id=abc",
-			Response: clusters.ClusterInfo{
-				State: "RUNNING",
-			},
-		},
-		{
-			Method:   "POST",
-			Resource: "/api/1.2/contexts/create",
-			Response: Command{
-				ID: "abc",
-			},
-		},
-		{
-			Method:   "GET",
-			Resource: "/api/1.2/contexts/status?clusterId=abc&contextId=abc",
-			Response: Command{
-				Status: "Running",
-			},
-		},
-		{
-			Method:   "POST",
-			Resource: "/api/1.2/commands/execute",
-			Response: Command{
-				ID: "abc",
-			},
-		},
-		{
-			Method:       "GET",
-			ReuseRequest: true,
-			Resource:     "/api/1.2/commands/status?clusterId=abc&commandId=abc&contextId=abc",
-			Response: Command{
-				Status: "Finished",
-			},
-		},
 		{
 			Method:   "POST",
 			Resource: "/api/1.2/contexts/destroy",
-			Status:   417,
-			Response: common.APIError{
-				Message: "Does not compute",
+			ExpectedRequest: genericCommandRequest{
+				ClusterID: "abc",
+				ContextID: "abc",
 			},
 		},
 	}, func(ctx context.Context, client *common.DatabricksClient) {
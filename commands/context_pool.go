@@ -0,0 +1,222 @@
+package commands
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+)
+
+const (
+	// DefaultContextIdleTimeout is how long the execution context could stay unused before it's destroyed
+	DefaultContextIdleTimeout = 5 * time.Minute
+
+	// maxIdleContexts per cluster and language. Clusters are limited to 150 execution contexts,
+	// so we keep only as much as Terraform runs operations in parallel by default.
+	maxIdleContexts = 10
+
+	// evictInterval is how often idle contexts are checked for expiration in background.
+	// It's the main guarantee of cleanup, as destroying contexts on exit is best effort.
+	evictInterval = time.Minute
+
+	// closeTimeout limits the time spent on destroying contexts, when the process exits.
+	// It has to be below 2 seconds, after which go-plugin kills the provider process,
+	// so contexts, that weren't destroyed in time, are left to expire on the cluster.
+	closeTimeout = 1500 * time.Millisecond
+)
+
+type contextKey struct {
+	clusterID string
+	language  string
+}
+
+type idleContext struct {
+	id       string
+	lastUsed time.Time
+}
+
+// contextPool keeps execution contexts of finished commands, so that subsequent commands
+// on the same cluster and language don't wait for the new context to start.
+// Contexts keep interpreter state of previous commands, as it's not reset on reuse.
+type contextPool struct {
+	client      *common.DatabricksClient
+	idleTimeout time.Duration
+	now         func() time.Time
+
+	mu   sync.Mutex
+	idle map[contextKey][]idleContext
+
+	stop     chan struct{}
+	stopOnce sync.Once
+}
+
+var (
+	poolsMu sync.Mutex
+	pools   = map[*common.DatabricksClient]*contextPool{}
+)
+
+// poolFor returns the context pool shared by all commands of the same client
+func poolFor(client *common.DatabricksClient) *contextPool {
+	poolsMu.Lock()
+	defer poolsMu.Unlock()
+	p, ok := pools[client]
+	if !ok {
+		p = &contextPool{
+			client:      client,
+			idleTimeout: DefaultContextIdleTimeout,
+			now:         time.Now,
+			idle:        map[contextKey][]idleContext{},
+			stop:        make(chan struct{}),
+		}
+		go p.evictPeriodically(evictInterval)
+		pools[client] = p
+	}
+	return p
+}
+
+// CloseContextPools destroys all idle execution contexts in parallel, giving up after a short timeout.
+// Must be called before the process exits.
+func CloseContextPools() {
+	poolsMu.Lock()
+	closing := pools
+	pools = map[*common.DatabricksClient]*contextPool{}
+	poolsMu.Unlock()
+	ctx, cancel := context.WithTimeout(context.Background(), closeTimeout)
+	defer cancel()
+	var wg sync.WaitGroup
+	for _, p := range closing {
+		wg.Add(1)
+		go func(p *contextPool) {
+			defer wg.Done()
+			p.close(ctx)
+		}(p)
+	}
+	wg.Wait()
+}
+
+// evictPeriodically destroys contexts, that were idle for too long, even if no commands
+// are executed anymore, until the pool is closed
+func (p *contextPool) evictPeriodically(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-p.stop:
+			return
+		case <-ticker.C:
+			p.mu.Lock()
+			expired := p.evictLocked()
+			p.mu.Unlock()
+			p.destroy(context.Background(), expired)
+		}
+	}
+}
+
+// take returns the most recently used idle context and evicts contexts, that were idle for too long.
+// Returned context is used exclusively by the caller until it's given back with put or discarded.
+func (p *contextPool) take(key contextKey) (string, bool) {
+	p.mu.Lock()
+	expired := p.evictLocked()
+	var id string
+	contexts := p.idle[key]
+	if len(contexts) > 0 {
+		id = contexts[len(contexts)-1].id
+		p.idle[key] = contexts[:len(contexts)-1]
+	}
+	p.mu.Unlock()
+	p.destroy(context.Background(), expired)
+	return id, id != ""
+}
+
+// put returns healthy context to the pool
+func (p *contextPool) put(key contextKey, id string) {
+	p.mu.Lock()
+	expired := p.evictLocked()
+	contexts := p.idle[key]
+	if len(contexts) >= maxIdleContexts {
+		expired[key] = append(expired[key], id)
+	} else {
+		p.idle[key] = append(contexts, idleContext{
+			id:       id,
+			lastUsed: p.now(),
+		})
+	}
+	p.mu.Unlock()
+	p.destroy(context.Background(), expired)
+}
+
+// discard destroys context, that is not healthy or has failed a command
+func (p *contextPool) discard(key contextKey, id string) {
+	p.destroy(context.Background(), map[contextKey][]string{
+		key: {id},
+	})
+}
+
+func (p *contextPool) evictLocked() map[contextKey][]string {
+	expired := map[contextKey][]string{}
+	deadline := p.now().Add(-p.idleTimeout)
+	for key, contexts := range p.idle {
+		fresh := contexts[:0]
+		for _, c := range contexts {
+			if c.lastUsed.Before(deadline) {
+				expired[key] = append(expired[key], c.id)
+				continue
+			}
+			fresh = append(fresh, c)
+		}
+		if len(fresh) == 0 {
+			delete(p.idle, key)
+			continue
+		}
+		p.idle[key] = fresh
+	}
+	return expired
+}
+
+func (p *contextPool) close(ctx context.Context) {
+	p.stopOnce.Do(func() {
+		if p.stop != nil {
+			close(p.stop)
+		}
+	})
+	p.mu.Lock()
+	all := map[contextKey][]string{}
+	for key, contexts := range p.idle {
+		for _, c := range contexts {
+			all[key] = append(all[key], c.id)
+		}
+	}
+	p.idle = map[contextKey][]idleContext{}
+	p.mu.Unlock()
+	p.destroy(ctx, all)
+}
+
+// destroy removes contexts in parallel on the best effort basis, as they are going to be removed
+// by cluster anyway once it's restarted
+func (p *contextPool) destroy(ctx context.Context, contexts map[contextKey][]string) {
+	if len(contexts) == 0 {
+		return
+	}
+	a := CommandsAPI{
+		client:  p.client,
+		context: context.WithValue(ctx, common.Api, common.API_1_2),
+		pool:    p,
+	}
+	var wg sync.WaitGroup
+	for key, ids := range contexts {
+		for _, id := range ids {
+			wg.Add(1)
+			go func(key contextKey, id string) {
+				defer wg.Done()
+				log.Printf("[DEBUG] Destroying execution context %s on %s", id, key.clusterID)
+				err := a.deleteContext(id, key.clusterID)
+				if err != nil {
+					log.Printf("[WARN] Cannot destroy execution context %s on %s: %s", id, key.clusterID, err)
+				}
+			}(key, id)
+		}
+	}
+	wg.Wait()
+}
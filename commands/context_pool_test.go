@@ -0,0 +1,321 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/databrickslabs/terraform-provider-databricks/clusters"
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+	"github.com/databrickslabs/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+)
+
+func reusableCommandFixtures() []qa.HTTPFixture {
+	return []qa.HTTPFixture{
+		{
+			Method:       "GET",
+			ReuseRequest: true,
+			Resource:     "/api/2.0/clusters/get?cluster_id=abc",
+			Response: clusters.ClusterInfo{
+				State: clusters.ClusterStateRunning,
+			},
+		},
+		{
+			Method:       "POST",
+			ReuseRequest: true,
+			Resource:     "/api/1.2/commands/execute",
+			Response: Command{
+				ID: "234",
+			},
+		},
+		{
+			Method:       "GET",
+			ReuseRequest: true,
+			Resource:     "/api/1.2/commands/status?clusterId=abc&commandId=234&contextId=123",
+			Response: Command{
+				Status: "Finished",
+				Results: &common.CommandResults{
+					ResultType: "text",
+					Data:       "done",
+				},
+			},
+		},
+	}
+}
+
+func TestCommandsAPIExecute_ReusesContext(t *testing.T) {
+	qa.HTTPFixturesApply(t, append(reusableCommandFixtures(), []qa.HTTPFixture{
+		{
+			Method:   "POST",
+			Resource: "/api/1.2/contexts/create",
+			Response: Command{
+				ID: "123",
+			},
+		},
+		{
+			Method:       "GET",
+			ReuseRequest: true,
+			Resource:     "/api/1.2/contexts/status?clusterId=abc&contextId=123",
+			Response: Command{
+				Status: "Running",
+			},
+		},
+		{
+			Method:   "POST",
+			Resource: "/api/1.2/contexts/destroy",
+			ExpectedRequest: genericCommandRequest{
+				ClusterID: "abc",
+				ContextID: "123",
+			},
+		},
+	}...), func(ctx context.Context, client *common.DatabricksClient) {
+		for i := 0; i < 3; i++ {
+			cr := NewCommandsAPI(ctx, client).Execute("abc", "python", `print("done")`)
+			assert.NoError(t, cr.Err())
+			assert.Equal(t, "done", cr.Text())
+		}
+		pool := poolFor(client)
+		assert.Len(t, pool.idle[contextKey{"abc", "python"}], 1)
+
+		poolsMu.Lock()
+		previous := pools
+		pools = map[*common.DatabricksClient]*contextPool{
+			client: pool,
+		}
+		poolsMu.Unlock()
+		defer func() {
+			poolsMu.Lock()
+			pools = previous
+			poolsMu.Unlock()
+		}()
+		CloseContextPools()
+		assert.Len(t, pool.idle, 0)
+	})
+}
+
+func TestCommandsAPIExecute_ReplacesUnhealthyContext(t *testing.T) {
+	qa.HTTPFixturesApply(t, append(reusableCommandFixtures(), []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/1.2/contexts/status?clusterId=abc&contextId=012",
+			Status:   404,
+			Response: common.APIError{
+				Message: "ContextNotFound",
+			},
+		},
+		{
+			Method:   "POST",
+			Resource: "/api/1.2/contexts/destroy",
+			ExpectedRequest: genericCommandRequest{
+				ClusterID: "abc",
+				ContextID: "012",
+			},
+			Status: 404,
+			Response: common.APIError{
+				Message: "ContextNotFound",
+			},
+		},
+		{
+			Method:   "POST",
+			Resource: "/api/1.2/contexts/create",
+			Response: Command{
+				ID: "123",
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/1.2/contexts/status?clusterId=abc&contextId=123",
+			Response: Command{
+				Status: "Running",
+			},
+		},
+	}...), func(ctx context.Context, client *common.DatabricksClient) {
+		key := contextKey{"abc", "python"}
+		pool := poolFor(client)
+		pool.put(key, "012")
+
+		cr := NewCommandsAPI(ctx, client).Execute("abc", "python", `print("done")`)
+		assert.NoError(t, cr.Err())
+		assert.Len(t, pool.idle[key], 1)
+		assert.Equal(t, "123", pool.idle[key][0].id)
+	})
+}
+
+func TestCommandsAPIExecute_DestroysContextOfFailedCommand(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/clusters/get?cluster_id=abc",
+			Response: clusters.ClusterInfo{
+				State: clusters.ClusterStateRunning,
+			},
+		},
+		{
+			Method:   "POST",
+			Resource: "/api/1.2/contexts/create",
+			Response: Command{
+				ID: "123",
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/1.2/contexts/status?clusterId=abc&contextId=123",
+			Response: Command{
+				Status: "Running",
+			},
+		},
+		{
+			Method:   "POST",
+			Resource: "/api/1.2/commands/execute",
+			Response: Command{
+				ID: "234",
+			},
+		},
+		{
+			Method:       "GET",
+			ReuseRequest: true,
+			Resource:     "/api/1.2/commands/status?clusterId=abc&commandId=234&contextId=123",
+			Response: Command{
+				Status: "Finished",
+				Results: &common.CommandResults{
+					ResultType: "error",
+					Cause:      "NameError: name 'x' is not defined",
+				},
+			},
+		},
+		{
+			Method:   "POST",
+			Resource: "/api/1.2/contexts/destroy",
+			ExpectedRequest: genericCommandRequest{
+				ClusterID: "abc",
+				ContextID: "123",
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		cr := NewCommandsAPI(ctx, client).Execute("abc", "python", `print(x)`)
+		assert.True(t, cr.Failed())
+		assert.Len(t, poolFor(client).idle, 0)
+	})
+}
+
+func TestContextPool_EvictsIdleContexts(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "POST",
+			Resource: "/api/1.2/contexts/destroy",
+			ExpectedRequest: genericCommandRequest{
+				ClusterID: "abc",
+				ContextID: "123",
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		now := time.Now()
+		pool := &contextPool{
+			client:      client,
+			idleTimeout: time.Minute,
+			now: func() time.Time {
+				return now
+			},
+			idle: map[contextKey][]idleContext{},
+		}
+		key := contextKey{"abc", "sql"}
+		pool.put(key, "123")
+
+		now = now.Add(2 * time.Minute)
+		_, ok := pool.take(key)
+		assert.False(t, ok)
+		assert.Len(t, pool.idle, 0)
+	})
+}
+
+func TestContextPool_ConcurrentTakeAndPut(t *testing.T) {
+	pool := &contextPool{
+		idleTimeout: time.Hour,
+		now:         time.Now,
+		idle:        map[contextKey][]idleContext{},
+	}
+	key := contextKey{"abc", "sql"}
+	for i := 0; i < maxIdleContexts; i++ {
+		pool.put(key, fmt.Sprintf("c%d", i))
+	}
+	var inUse sync.Map
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				id, ok := pool.take(key)
+				if !ok {
+					continue
+				}
+				_, taken := inUse.LoadOrStore(id, true)
+				assert.False(t, taken, "context %s is used by two commands", id)
+				inUse.Delete(id)
+				pool.put(key, id)
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Len(t, pool.idle[key], maxIdleContexts)
+}
+
+func TestContextPool_EvictsIdleContextsPeriodically(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "POST",
+			Resource: "/api/1.2/contexts/destroy",
+			ExpectedRequest: genericCommandRequest{
+				ClusterID: "abc",
+				ContextID: "123",
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		pool := &contextPool{
+			client:      client,
+			idleTimeout: time.Minute,
+			now:         time.Now,
+			idle: map[contextKey][]idleContext{
+				{"abc", "sql"}: {
+					{id: "123", lastUsed: time.Now().Add(-2 * time.Minute)},
+				},
+			},
+			stop: make(chan struct{}),
+		}
+		go pool.evictPeriodically(10 * time.Millisecond)
+		assert.Eventually(t, func() bool {
+			pool.mu.Lock()
+			defer pool.mu.Unlock()
+			return len(pool.idle) == 0
+		}, time.Second, 10*time.Millisecond)
+		pool.close(ctx)
+		// closing twice doesn't panic
+		pool.close(ctx)
+	})
+}
+
+func TestContextPool_CloseDestroysInParallel(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			// contexts are destroyed in no particular order
+			Method:       "POST",
+			Resource:     "/api/1.2/contexts/destroy",
+			ReuseRequest: true,
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		pool := &contextPool{
+			client:      client,
+			idleTimeout: time.Hour,
+			now:         time.Now,
+			idle:        map[contextKey][]idleContext{},
+		}
+		key := contextKey{"abc", "python"}
+		pool.put(key, "1")
+		pool.put(key, "2")
+		pool.close(ctx)
+		assert.Len(t, pool.idle, 0)
+	})
+}
@@ -392,6 +392,15 @@ func (c *DatabricksClient) configureHTTPCLient() {
 	}
 }
 
+// Transport returns HTTP transport of the provider, so that requests to services other than
+// Databricks APIs respect the same proxy and TLS settings. It never adds credentials to requests.
+func (c *DatabricksClient) Transport() http.RoundTripper {
+	if c.httpClient == nil || c.httpClient.HTTPClient == nil {
+		return http.DefaultTransport
+	}
+	return c.httpClient.HTTPClient.Transport
+}
+
 // IsAzure returns true if client is configured for Azure Databricks - either by using AAD auth or with host+token combination
 func (c *DatabricksClient) IsAzure() bool {
 	return c.AzureResourceID != "" || c.AzureClientID != "" || c.AzureUseMSI || strings.Contains(c.Host, ".azuredatabricks.net")
@@ -2,6 +2,7 @@ package common
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"html"
 	"regexp"
@@ -19,6 +20,12 @@ var (
 	executionErrorRE = regexp.MustCompile(`ExecutionError: ([\s\S]*)\n(StatusCode=[0-9]*)\n(StatusDescription=.*)\n`)
 	// usual error message explanation is hidden in this key
 	errorMessageRE = regexp.MustCompile(`ErrorMessage=(.+)\n`)
+	// class and message of JVM or Python exception
+	exceptionLineRE = regexp.MustCompile(`^([\w.$]*(?:Exception|Error)): (.*)$`)
+	// terminal colors in Python tracebacks
+	ansiRE = regexp.MustCompile(`\x1b\[[0-9;]*m`)
+	// notebook output is cut in the middle, once it's larger than the limit
+	truncatedTextRE = regexp.MustCompile(`\*\*\* WARNING: skipped \d+ bytes of output \*\*\*`)
 )
 
 // WithCommandMock mocks all command executions for this client
@@ -119,6 +126,124 @@ func (cr *CommandResults) Error() string {
 	return summary
 }
 
+// ColumnSchema describes a column of table results
+type ColumnSchema struct {
+	Name string
+	Type string
+}
+
+// Columns returns schema of table results with Spark SQL types, like string or integer
+func (cr *CommandResults) Columns() (columns []ColumnSchema) {
+	if cr.ResultType != "table" {
+		return
+	}
+	fields, ok := cr.Schema.([]interface{})
+	if !ok {
+		return
+	}
+	for _, f := range fields {
+		field, ok := f.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		column := ColumnSchema{}
+		column.Name, _ = field["name"].(string)
+		column.Type, _ = field["type"].(string)
+		// simple types are returned as JSON-encoded strings, like "\"string\""
+		var simpleType string
+		if json.Unmarshal([]byte(column.Type), &simpleType) == nil {
+			column.Type = simpleType
+		}
+		columns = append(columns, column)
+	}
+	return
+}
+
+// Rows decodes table results into a pointer to slice of structs,
+// matching columns by name with json tags of struct fields
+func (cr *CommandResults) Rows(dest interface{}) error {
+	if cr.ResultType != "table" {
+		return fmt.Errorf("expected table results, got %s", cr.ResultType)
+	}
+	columns := cr.Columns()
+	rows, _ := cr.Data.([]interface{})
+	records := []map[string]interface{}{}
+	for _, r := range rows {
+		cols, ok := r.([]interface{})
+		if !ok {
+			continue
+		}
+		if len(cols) != len(columns) {
+			return fmt.Errorf("row has %d values, but schema has %d columns", len(cols), len(columns))
+		}
+		record := map[string]interface{}{}
+		for i, column := range columns {
+			record[column.Name] = cols[i]
+		}
+		records = append(records, record)
+	}
+	raw, err := json.Marshal(records)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(raw, dest)
+}
+
+// IsTruncated tells if API has cut results, because they exceeded the size limit
+func (cr *CommandResults) IsTruncated() bool {
+	if cr.Truncated {
+		return true
+	}
+	text, ok := cr.Data.(string)
+	return ok && cr.ResultType == "text" && truncatedTextRE.MatchString(text)
+}
+
+// CommandError is the exception, that has failed the command
+type CommandError struct {
+	Class      string
+	Message    string
+	StackTrace []string
+}
+
+// ErrorDetails parses class, message and stack trace of JVM or Python exception from the cause
+func (cr *CommandResults) ErrorDetails() (ce CommandError) {
+	if cr.ResultType != "error" {
+		return
+	}
+	python := strings.Contains(cr.Cause, "Traceback (most recent call last)")
+	inTraceback := false
+	cause := ansiRE.ReplaceAllLiteralString(cr.Cause, "")
+	for _, line := range strings.Split(cause, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.Trim(trimmed, "-") == "" {
+			continue
+		}
+		if m := exceptionLineRE.FindStringSubmatch(trimmed); len(m) == 3 {
+			// thrown exception is printed first by JVM and last by Python
+			if ce.Class == "" || python {
+				ce.Class = m[1]
+				ce.Message = m[2]
+			}
+			continue
+		}
+		if strings.HasPrefix(trimmed, "at ") {
+			ce.StackTrace = append(ce.StackTrace, strings.TrimPrefix(trimmed, "at "))
+			continue
+		}
+		if python && strings.Contains(trimmed, "Traceback (most recent call last)") {
+			inTraceback = true
+			continue
+		}
+		if inTraceback {
+			ce.StackTrace = append(ce.StackTrace, trimmed)
+		}
+	}
+	if ce.Message == "" {
+		ce.Message = cr.Error()
+	}
+	return
+}
+
 // Scan scans for results
 func (cr *CommandResults) Scan(dest ...interface{}) bool {
 	if cr.ResultType != "table" {
@@ -74,3 +74,109 @@ func TestCommandResults_Scan(t *testing.T) {
 
 	assert.False(t, cr.Scan(&a, &b, &c))
 }
+
+func TestCommandResults_Rows(t *testing.T) {
+	cr := CommandResults{
+		ResultType: "table",
+		Schema: []interface{}{
+			map[string]interface{}{"name": "Principal", "type": `"string"`, "metadata": "{}"},
+			map[string]interface{}{"name": "Count", "type": `"integer"`, "metadata": "{}"},
+			map[string]interface{}{"name": "Tags", "type": `{"type":"array","elementType":"string"}`},
+		},
+		Data: []interface{}{
+			[]interface{}{"users", 1, []interface{}{"a"}},
+			[]interface{}{"admins", 2, nil},
+		},
+	}
+	assert.Equal(t, []ColumnSchema{
+		{"Principal", "string"},
+		{"Count", "integer"},
+		{"Tags", `{"type":"array","elementType":"string"}`},
+	}, cr.Columns())
+
+	type row struct {
+		Principal string   `json:"principal"`
+		Count     int      `json:"Count"`
+		Tags      []string `json:"Tags"`
+	}
+	var rows []row
+	err := cr.Rows(&rows)
+	assert.NoError(t, err)
+	assert.Equal(t, []row{
+		{"users", 1, []string{"a"}},
+		{"admins", 2, nil},
+	}, rows)
+
+	cr.Data = []interface{}{
+		[]interface{}{"users"},
+	}
+	assert.EqualError(t, cr.Rows(&rows), "row has 1 values, but schema has 3 columns")
+
+	cr.ResultType = "text"
+	assert.Nil(t, cr.Columns())
+	assert.EqualError(t, cr.Rows(&rows), "expected table results, got text")
+}
+
+func TestCommandResults_IsTruncated(t *testing.T) {
+	cr := CommandResults{
+		ResultType: "text",
+		Data:       "abc",
+	}
+	assert.False(t, cr.IsTruncated())
+
+	cr.Data = "abc\n*** WARNING: skipped 1234 bytes of output ***\nxyz"
+	assert.True(t, cr.IsTruncated())
+
+	cr = CommandResults{
+		ResultType: "table",
+		Truncated:  true,
+	}
+	assert.True(t, cr.IsTruncated())
+}
+
+func TestCommandResults_ErrorDetailsJVM(t *testing.T) {
+	cr := CommandResults{
+		ResultType: "error",
+		Summary:    "org.apache.spark.sql.AnalysisException: Table or view not found: foo",
+		Cause: `org.apache.spark.sql.AnalysisException: Table or view not found: foo; line 1 pos 14
+	at org.apache.spark.sql.catalyst.analysis.package$AnalysisErrorAt.failAnalysis(package.scala:42)
+	at org.apache.spark.sql.catalyst.analysis.CheckAnalysis.checkAnalysis(CheckAnalysis.scala:96)
+Caused by: java.lang.IllegalStateException: inner
+	at com.databricks.Foo.bar(Foo.scala:1)`,
+	}
+	ce := cr.ErrorDetails()
+	assert.Equal(t, "org.apache.spark.sql.AnalysisException", ce.Class)
+	assert.Equal(t, "Table or view not found: foo; line 1 pos 14", ce.Message)
+	assert.Equal(t, []string{
+		"org.apache.spark.sql.catalyst.analysis.package$AnalysisErrorAt.failAnalysis(package.scala:42)",
+		"org.apache.spark.sql.catalyst.analysis.CheckAnalysis.checkAnalysis(CheckAnalysis.scala:96)",
+		"com.databricks.Foo.bar(Foo.scala:1)",
+	}, ce.StackTrace)
+}
+
+func TestCommandResults_ErrorDetailsPython(t *testing.T) {
+	cr := CommandResults{
+		ResultType: "error",
+		Cause: "---------------------------------------------------------------------------\n" +
+			"\x1b[0;31mException\x1b[0m                                 Traceback (most recent call last)\n" +
+			"\x1b[0;32m<command-123>\x1b[0m in \x1b[0;36m<module>\x1b[0;34m\x1b[0m\n" +
+			"\x1b[0;32m----> 1\x1b[0;31m \x1b[0;32mraise\x1b[0m \x1b[0mException\x1b[0m\x1b[0;34m(\x1b[0m\x1b[0;34m\"Mount not found\"\x1b[0m\x1b[0;34m)\x1b[0m\x1b[0;34m\x1b[0m\x1b[0m\n" +
+			"\n" +
+			"\x1b[0;31mException\x1b[0m: Mount not found",
+	}
+	ce := cr.ErrorDetails()
+	assert.Equal(t, "Exception", ce.Class)
+	assert.Equal(t, "Mount not found", ce.Message)
+	assert.Equal(t, []string{
+		"<command-123> in <module>",
+		"----> 1 raise Exception(\"Mount not found\")",
+	}, ce.StackTrace)
+
+	cr = CommandResults{
+		ResultType: "error",
+		Summary:    "Something went wrong",
+	}
+	assert.Equal(t, CommandError{Message: "Something went wrong"}, cr.ErrorDetails())
+	cr.ResultType = "text"
+	assert.Equal(t, CommandError{}, cr.ErrorDetails())
+}
@@ -2,6 +2,7 @@ package common
 
 import (
 	"context"
+	"errors"
 	"log"
 	"regexp"
 	"strings"
@@ -23,19 +24,44 @@ type Resource struct {
 	Timeouts       *schema.ResourceTimeout
 }
 
+// Warnings are returned from Create or Update, when the operation succeeded,
+// but there are problems, that practitioner has to know about
+type Warnings []string
+
+func (w Warnings) Error() string {
+	return strings.Join(w, "\n")
+}
+
+// withWarnings converts Warnings into diagnostics and keeps other errors as they are
+func withWarnings(err error) (diag.Diagnostics, error) {
+	var warnings Warnings
+	if !errors.As(err, &warnings) {
+		return nil, err
+	}
+	var diags diag.Diagnostics
+	for _, w := range warnings {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  w,
+		})
+	}
+	return diags, nil
+}
+
 // ToResource converts to Terraform resource definition
 func (r Resource) ToResource() *schema.Resource {
 	var update func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics
 	if r.Update != nil {
 		update = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 			c := m.(*DatabricksClient)
-			if err := r.Update(ctx, d, c); err != nil {
+			diags, err := withWarnings(r.Update(ctx, d, c))
+			if err != nil {
 				return diag.FromErr(err)
 			}
 			if err := r.Read(ctx, d, c); err != nil {
-				return diag.FromErr(err)
+				return append(diags, diag.FromErr(err)...)
 			}
-			return nil
+			return diags
 		}
 	} else {
 		// set ForceNew to all attributes with CRD
@@ -66,14 +92,14 @@ func (r Resource) ToResource() *schema.Resource {
 		CustomizeDiff:  r.CustomizeDiff,
 		CreateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 			c := m.(*DatabricksClient)
-			err := r.Create(ctx, d, c)
+			diags, err := withWarnings(r.Create(ctx, d, c))
 			if err != nil {
 				return diag.FromErr(err)
 			}
 			if err = r.Read(ctx, d, c); err != nil {
-				return diag.FromErr(err)
+				return append(diags, diag.FromErr(err)...)
 			}
-			return nil
+			return diags
 		},
 		ReadContext:   read,
 		UpdateContext: update,
@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -69,3 +70,34 @@ func TestUpdate(t *testing.T) {
 	assert.True(t, diags.HasError())
 	assert.Equal(t, "nope", diags[0].Summary)
 }
+
+func TestCreateWithWarnings(t *testing.T) {
+	r := Resource{
+		Create: func(ctx context.Context,
+			d *schema.ResourceData,
+			c *DatabricksClient) error {
+			d.SetId("abc")
+			return Warnings{"a is uninstalled", "b is uninstalled"}
+		},
+		Read: func(ctx context.Context,
+			d *schema.ResourceData,
+			c *DatabricksClient) error {
+			return d.Set("foo", 1)
+		},
+		Schema: map[string]*schema.Schema{
+			"foo": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+		},
+	}.ToResource()
+
+	d := r.TestResourceData()
+	diags := r.CreateContext(context.Background(), d, &DatabricksClient{})
+	assert.False(t, diags.HasError())
+	require.Len(t, diags, 2)
+	assert.Equal(t, diag.Warning, diags[0].Severity)
+	assert.Equal(t, "a is uninstalled", diags[0].Summary)
+	assert.Equal(t, "abc", d.Id())
+	assert.Equal(t, 1, d.Get("foo"))
+}
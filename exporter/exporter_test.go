@@ -217,7 +217,7 @@ func TestImportingUsersGroupsSecretScopes(t *testing.T) {
 			repoListFixture,
 			{
 				Method:   "GET",
-				Resource: "/api/2.0/preview/scim/v2/Groups?",
+				Resource: "/api/2.0/preview/scim/v2/Groups?count=100&startIndex=1",
 				Response: identity.GroupList{
 					Resources: []identity.ScimGroup{
 						// TODO: add another user for which there is no filter resut
@@ -278,7 +278,7 @@ func TestImportingUsersGroupsSecretScopes(t *testing.T) {
 			},
 			{
 				Method:   "GET",
-				Resource: "/api/2.0/preview/scim/v2/Users?filter=userName%20eq%20%27test%40test.com%27",
+				Resource: "/api/2.0/preview/scim/v2/Users?count=100&filter=userName+eq+%27test%40test.com%27&startIndex=1",
 				Response: identity.UserList{
 					Resources: []identity.ScimUser{
 						{ID: "123", DisplayName: "test@test.com", UserName: "test@test.com"},
@@ -370,7 +370,7 @@ func TestImportingNoResourcesError(t *testing.T) {
 			repoListFixture,
 			{
 				Method:   "GET",
-				Resource: "/api/2.0/preview/scim/v2/Groups?",
+				Resource: "/api/2.0/preview/scim/v2/Groups?count=100&startIndex=1",
 				Response: identity.GroupList{Resources: []identity.ScimGroup{}},
 			},
 			{
@@ -421,7 +421,7 @@ func TestImportingClusters(t *testing.T) {
 			repoListFixture,
 			{
 				Method:   "GET",
-				Resource: "/api/2.0/preview/scim/v2/Groups?",
+				Resource: "/api/2.0/preview/scim/v2/Groups?count=100&startIndex=1",
 				Response: identity.GroupList{Resources: []identity.ScimGroup{}},
 			},
 			{
@@ -787,7 +787,7 @@ func TestImportingSecrets(t *testing.T) {
 			repoListFixture,
 			{
 				Method:   "GET",
-				Resource: "/api/2.0/preview/scim/v2/Groups?",
+				Resource: "/api/2.0/preview/scim/v2/Groups?count=100&startIndex=1",
 				Response: identity.GroupList{Resources: []identity.ScimGroup{}},
 			},
 			{
@@ -903,7 +903,7 @@ func TestImportingUser(t *testing.T) {
 			{
 				Method:       "GET",
 				ReuseRequest: true,
-				Resource:     "/api/2.0/preview/scim/v2/Users?filter=userName%20eq%20%27me%27",
+				Resource:     "/api/2.0/preview/scim/v2/Users?count=100&filter=userName+eq+%27me%27&startIndex=1",
 				Response: identity.UserList{
 					Resources: []identity.ScimUser{
 						{
@@ -1,16 +1,19 @@
 package exporter
 
 import (
+	"context"
 	"encoding/json"
 	"testing"
 
 	"github.com/databrickslabs/terraform-provider-databricks/access"
+	"github.com/databrickslabs/terraform-provider-databricks/clusters"
 	"github.com/databrickslabs/terraform-provider-databricks/common"
 	"github.com/databrickslabs/terraform-provider-databricks/identity"
 	"github.com/databrickslabs/terraform-provider-databricks/permissions"
 	"github.com/databrickslabs/terraform-provider-databricks/policies"
 	"github.com/databrickslabs/terraform-provider-databricks/pools"
 	"github.com/databrickslabs/terraform-provider-databricks/provider"
+	"github.com/hashicorp/hcl/v2/hclwrite"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -44,6 +47,30 @@ func TestInstancePool(t *testing.T) {
 	assert.True(t, ic.testEmits["databricks_permissions[inst_pool_def] (id: /instance-pools/abc)"])
 }
 
+func TestInstancePoolGcpAttributes(t *testing.T) {
+	ic := importContextForTest()
+
+	pool := pools.ResourceInstancePool().TestResourceData()
+	pool.Set("instance_pool_name", "gcp")
+	pool.Set("node_type_id", "n1-standard-4")
+	pool.Set("gcp_attributes", []interface{}{
+		map[string]interface{}{
+			"gcp_availability":       string(clusters.GcpAvailabilityPreemptible),
+			"local_ssd_count":        1,
+			"boot_disk_size":         100,
+			"google_service_account": "sa@prj.iam.gserviceaccount.com",
+		},
+	})
+	f := hclwrite.NewEmptyFile()
+	err := ic.dataToHcl(ic.Importables["databricks_instance_pool"], []string{},
+		ic.Resources["databricks_instance_pool"], pool, f.Body())
+	assert.NoError(t, err)
+	assert.Contains(t, string(f.Bytes()), `gcp_availability       = "PREEMPTIBLE_GCP"`)
+	assert.Contains(t, string(f.Bytes()), `local_ssd_count        = 1`)
+	assert.Contains(t, string(f.Bytes()), `boot_disk_size         = 100`)
+	assert.Contains(t, string(f.Bytes()), `google_service_account = "sa@prj.iam.gserviceaccount.com"`)
+}
+
 func TestClusterPolicy(t *testing.T) {
 	d := policies.ResourceClusterPolicy().TestResourceData()
 	d.Set("name", "bcd")
@@ -156,3 +183,16 @@ func TestSecretScope(t *testing.T) {
 	name := ic.Importables["databricks_secret_scope"].Name(d)
 	assert.Equal(t, "abc", name)
 }
+
+func TestGetMountsThroughCluster_Truncated(t *testing.T) {
+	client := &common.DatabricksClient{}
+	client.WithCommandMock(func(commandStr string) common.CommandResults {
+		return common.CommandResults{
+			ResultType: "text",
+			Data:       "{\"a\":\"s3a://a\",\n*** WARNING: skipped 65536 bytes of output ***\n\"z\":\"s3a://z\"}",
+		}
+	})
+	ic := importContextForTest()
+	_, err := ic.getMountsThroughCluster(client.CommandExecutor(context.Background()), "abc")
+	assert.EqualError(t, err, "list of mounts is too large and got truncated")
+}
@@ -66,11 +66,11 @@ func (ic *importContext) cacheGroups() error {
 	if len(ic.allGroups) == 0 {
 		log.Printf("[INFO] Caching groups in memory ...")
 		groupsAPI := identity.NewGroupsAPI(ic.Context, ic.Client)
-		g, err := groupsAPI.Filter("")
+		groups, err := groupsAPI.List(identity.ListRequest{})
 		if err != nil {
 			return err
 		}
-		ic.allGroups = g.Resources
+		ic.allGroups = groups
 		log.Printf("[INFO] Cached %d groups", len(ic.allGroups))
 	}
 	return nil
@@ -227,6 +227,10 @@ func (ic *importContext) getMountsThroughCluster(
 		err = result.Err()
 		return
 	}
+	if result.IsTruncated() {
+		err = fmt.Errorf("list of mounts is too large and got truncated")
+		return
+	}
 	lines := strings.Split(result.Text(), "\n")
 	err = json.Unmarshal([]byte(lines[0]), &mm)
 	return
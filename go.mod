@@ -23,4 +23,5 @@ require (
 	golang.org/x/time v0.0.0-20210723032227-1f47c861a9ac
 	google.golang.org/api v0.60.0
 	gopkg.in/ini.v1 v1.66.0
+	gopkg.in/yaml.v3 v3.0.1
 )
@@ -0,0 +1,155 @@
+package identity
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// EffectiveGroup is a group, that principal is a direct or transitive member of
+type EffectiveGroup struct {
+	ID          string   `json:"id,omitempty"`
+	DisplayName string   `json:"display_name,omitempty"`
+	Path        []string `json:"path,omitempty"`
+}
+
+// EffectiveGrant is an entitlement or instance profile together with the chain of
+// principal and groups, through which it is granted
+type EffectiveGrant struct {
+	Value string   `json:"value,omitempty"`
+	Path  []string `json:"path,omitempty"`
+}
+
+// EffectiveAccess is everything, that principal ends up with after expanding nested groups
+type EffectiveAccess struct {
+	Groups           []EffectiveGroup
+	Entitlements     []EffectiveGrant
+	InstanceProfiles []EffectiveGrant
+}
+
+func (ea *EffectiveAccess) grant(granted map[string]bool, entitlements, roles []ComplexValue, path []string) {
+	for _, e := range entitlements {
+		name, ok := entitlementMapping[e.Value]
+		if !ok || granted["entitlement:"+name] {
+			continue
+		}
+		granted["entitlement:"+name] = true
+		ea.Entitlements = append(ea.Entitlements, EffectiveGrant{Value: name, Path: path})
+	}
+	for _, r := range roles {
+		if granted["role:"+r.Value] {
+			continue
+		}
+		granted["role:"+r.Value] = true
+		ea.InstanceProfiles = append(ea.InstanceProfiles, EffectiveGrant{Value: r.Value, Path: path})
+	}
+}
+
+// ResolveEffectiveAccess expands nested groups of principal breadth-first, so that every grant
+// is explained by the shortest chain of groups
+func (a GroupsAPI) ResolveEffectiveAccess(principal ScimUser) (ea EffectiveAccess, err error) {
+	name := principal.UserName
+	if name == "" {
+		name = principal.DisplayName
+	}
+	granted := map[string]bool{}
+	ea.Groups = []EffectiveGroup{}
+	ea.Entitlements = []EffectiveGrant{}
+	ea.InstanceProfiles = []EffectiveGrant{}
+	ea.grant(granted, principal.Entitlements, principal.Roles, []string{name})
+	type step struct {
+		groupID string
+		path    []string
+	}
+	queue := []step{}
+	visited := map[string]bool{}
+	enqueue := func(groups []ComplexValue, path []string) {
+		for _, g := range groups {
+			if visited[g.Value] {
+				continue
+			}
+			visited[g.Value] = true
+			queue = append(queue, step{g.Value, path})
+		}
+	}
+	enqueue(principal.Groups, []string{name})
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		group, err := a.ReadWithoutMembers(current.groupID)
+		if err != nil {
+			return ea, fmt.Errorf("cannot read group %s: %w", current.groupID, err)
+		}
+		path := append(append([]string{}, current.path...), group.DisplayName)
+		ea.Groups = append(ea.Groups, EffectiveGroup{
+			ID:          group.ID,
+			DisplayName: group.DisplayName,
+			Path:        path,
+		})
+		ea.grant(granted, group.Entitlements, group.Roles, path)
+		// groups of a group are the ones, it is a member of
+		enqueue(group.Groups, path)
+	}
+	return ea, nil
+}
+
+// DataSourceEffectiveAccess returns transitive group memberships, entitlements and
+// instance profiles of a user or a service principal
+func DataSourceEffectiveAccess() *schema.Resource {
+	type effectiveAccess struct {
+		UserName         string           `json:"user_name,omitempty"`
+		ApplicationID    string           `json:"application_id,omitempty"`
+		Groups           []EffectiveGroup `json:"groups,omitempty" tf:"computed"`
+		Entitlements     []EffectiveGrant `json:"entitlements,omitempty" tf:"computed"`
+		InstanceProfiles []EffectiveGrant `json:"instance_profiles,omitempty" tf:"computed"`
+	}
+	s := common.StructToSchema(effectiveAccess{}, func(
+		s map[string]*schema.Schema) map[string]*schema.Schema {
+		principal := []string{"user_name", "application_id"}
+		s["user_name"].ExactlyOneOf = principal
+		s["application_id"].ExactlyOneOf = principal
+		return s
+	})
+	return &schema.Resource{
+		Schema: s,
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			var this effectiveAccess
+			err := common.DataToStructPointer(d, s, &this)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			var principals []ScimUser
+			if this.UserName != "" {
+				principals, err = NewUsersAPI(ctx, m).List(ListRequest{
+					Filter: fmt.Sprintf("userName eq '%s'", this.UserName),
+				})
+			} else {
+				principals, err = NewServicePrincipalsAPI(ctx, m).List(ListRequest{
+					Filter: fmt.Sprintf("applicationId eq '%s'", this.ApplicationID),
+				})
+			}
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			if len(principals) == 0 {
+				return diag.Errorf("cannot find principal %s%s", this.UserName, this.ApplicationID)
+			}
+			ea, err := NewGroupsAPI(ctx, m).ResolveEffectiveAccess(principals[0])
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			this.Groups = ea.Groups
+			this.Entitlements = ea.Entitlements
+			this.InstanceProfiles = ea.InstanceProfiles
+			d.SetId(principals[0].ID)
+			err = common.StructToData(this, s, d)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			return nil
+		},
+	}
+}
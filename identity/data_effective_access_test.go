@@ -0,0 +1,148 @@
+package identity
+
+import (
+	"testing"
+
+	"github.com/databrickslabs/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+)
+
+func effectiveGroupFixture(group ScimGroup) qa.HTTPFixture {
+	return qa.HTTPFixture{
+		Method: "GET",
+		Resource: "/api/2.0/preview/scim/v2/Groups/" + group.ID +
+			"?attributes=id%2CdisplayName%2Cgroups%2Croles%2Centitlements",
+		Response: group,
+	}
+}
+
+func TestDataSourceEffectiveAccess_User(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/scim/v2/Users?count=100&filter=userName+eq+%27me%40example.com%27&startIndex=1",
+				Response: UserList{
+					Resources: []ScimUser{
+						{
+							ID:       "123",
+							UserName: "me@example.com",
+							Entitlements: []ComplexValue{
+								{Value: "workspace-access"},
+							},
+							Groups: []ComplexValue{
+								{Value: "ds"},
+								{Value: "users"},
+							},
+						},
+					},
+				},
+			},
+			effectiveGroupFixture(ScimGroup{
+				ID:          "ds",
+				DisplayName: "Data Scientists",
+				Groups: []ComplexValue{
+					{Value: "analytics"},
+				},
+			}),
+			effectiveGroupFixture(ScimGroup{
+				ID:          "users",
+				DisplayName: "users",
+				Entitlements: []ComplexValue{
+					{Value: "workspace-access"},
+					{Value: "databricks-sql-access"},
+				},
+			}),
+			effectiveGroupFixture(ScimGroup{
+				ID:          "analytics",
+				DisplayName: "Analytics",
+				Roles: []ComplexValue{
+					{Value: "arn:aws:iam::999:instance-profile/analytics"},
+				},
+				Entitlements: []ComplexValue{
+					{Value: "allow-cluster-create"},
+				},
+				Groups: []ComplexValue{
+					// cycles are not followed
+					{Value: "ds"},
+				},
+			}),
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceEffectiveAccess(),
+		ID:          ".",
+		HCL:         `user_name = "me@example.com"`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "123", d.Id())
+	assert.Equal(t, 3, d.Get("groups.#"))
+	assert.Equal(t, "Analytics", d.Get("groups.2.display_name"))
+	assert.Equal(t, []interface{}{"me@example.com", "Data Scientists", "Analytics"},
+		d.Get("groups.2.path"))
+
+	assert.Equal(t, 3, d.Get("entitlements.#"))
+	assert.Equal(t, "workspace_access", d.Get("entitlements.0.value"))
+	assert.Equal(t, []interface{}{"me@example.com"}, d.Get("entitlements.0.path"))
+	assert.Equal(t, "allow_sql_analytics_access", d.Get("entitlements.1.value"))
+	assert.Equal(t, "allow_cluster_create", d.Get("entitlements.2.value"))
+
+	assert.Equal(t, "arn:aws:iam::999:instance-profile/analytics",
+		d.Get("instance_profiles.0.value"))
+	assert.Equal(t, 3, d.Get("instance_profiles.0.path.#"))
+}
+
+func TestDataSourceEffectiveAccess_ServicePrincipal(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/scim/v2/ServicePrincipals?count=100&filter=applicationId+eq+%27abc%27&startIndex=1",
+				Response: UserList{
+					Resources: []ScimUser{
+						{
+							ID:            "456",
+							ApplicationID: "abc",
+							DisplayName:   "etl",
+							Groups: []ComplexValue{
+								{Value: "admins"},
+							},
+						},
+					},
+				},
+			},
+			effectiveGroupFixture(ScimGroup{
+				ID:          "admins",
+				DisplayName: "admins",
+				Entitlements: []ComplexValue{
+					{Value: "allow-instance-pool-create"},
+				},
+			}),
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceEffectiveAccess(),
+		ID:          ".",
+		HCL:         `application_id = "abc"`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "456", d.Id())
+	assert.Equal(t, []interface{}{"etl", "admins"}, d.Get("entitlements.0.path"))
+}
+
+func TestDataSourceEffectiveAccess_NotFound(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/scim/v2/Users?count=100&filter=userName+eq+%27nobody%27&startIndex=1",
+				Response: UserList{},
+			},
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceEffectiveAccess(),
+		ID:          ".",
+		HCL:         `user_name = "nobody"`,
+	}.ExpectError(t, "cannot find principal nobody")
+}
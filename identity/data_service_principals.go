@@ -0,0 +1,66 @@
+package identity
+
+import (
+	"context"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ServicePrincipalSummary is the subset of service principal attributes exposed by listing data sources
+type ServicePrincipalSummary struct {
+	ID            string `json:"id,omitempty"`
+	ApplicationID string `json:"application_id,omitempty"`
+	DisplayName   string `json:"display_name,omitempty"`
+	ExternalID    string `json:"external_id,omitempty"`
+	Active        bool   `json:"active,omitempty"`
+}
+
+// DataSourceServicePrincipals returns service principals matching SCIM filter expression
+func DataSourceServicePrincipals() *schema.Resource {
+	type servicePrincipalsFilter struct {
+		Filter            string                    `json:"filter,omitempty"`
+		ApplicationIDs    []string                  `json:"application_ids,omitempty" tf:"computed,slice_set"`
+		ServicePrincipals []ServicePrincipalSummary `json:"service_principals,omitempty" tf:"computed"`
+	}
+	s := common.StructToSchema(servicePrincipalsFilter{}, func(
+		s map[string]*schema.Schema) map[string]*schema.Schema {
+		return s
+	})
+	return &schema.Resource{
+		Schema: s,
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			var this servicePrincipalsFilter
+			err := common.DataToStructPointer(d, s, &this)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			this.ApplicationIDs = []string{}
+			this.ServicePrincipals = []ServicePrincipalSummary{}
+			err = NewServicePrincipalsAPI(ctx, m).ForEach(ListRequest{
+				Filter:     this.Filter,
+				Attributes: "id,applicationId,displayName,externalId,active",
+			}, func(sp ScimUser) error {
+				this.ApplicationIDs = append(this.ApplicationIDs, sp.ApplicationID)
+				this.ServicePrincipals = append(this.ServicePrincipals, ServicePrincipalSummary{
+					ID:            sp.ID,
+					ApplicationID: sp.ApplicationID,
+					DisplayName:   sp.DisplayName,
+					ExternalID:    sp.ExternalID,
+					Active:        sp.Active,
+				})
+				return nil
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			d.SetId(filterID("service-principals", this.Filter))
+			err = common.StructToData(this, s, d)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			return nil
+		},
+	}
+}
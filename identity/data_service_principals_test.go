@@ -0,0 +1,46 @@
+package identity
+
+import (
+	"testing"
+
+	"github.com/databrickslabs/terraform-provider-databricks/qa"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataSourceServicePrincipals(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method: "GET",
+				Resource: "/api/2.0/preview/scim/v2/ServicePrincipals?attributes=id%2CapplicationId%2CdisplayName%2CexternalId%2Cactive" +
+					"&count=100&startIndex=1",
+				Response: UserList{
+					TotalResults: 2,
+					Resources: []ScimUser{
+						{
+							ID:            "123",
+							ApplicationID: "00000000-0000-0000-0000-000000000001",
+							DisplayName:   "etl",
+							Active:        true,
+						},
+						{
+							ID:            "456",
+							ApplicationID: "00000000-0000-0000-0000-000000000002",
+							DisplayName:   "ci",
+						},
+					},
+				},
+			},
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceServicePrincipals(),
+		ID:          ".",
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.True(t, d.Get("application_ids").(*schema.Set).Contains(
+		"00000000-0000-0000-0000-000000000002"))
+	assert.Equal(t, "etl", d.Get("service_principals.0.display_name"))
+	assert.Equal(t, "123", d.Get("service_principals.0.id"))
+}
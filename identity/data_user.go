@@ -13,7 +13,10 @@ func getUser(usersAPI UsersAPI, id, name string) (user ScimUser, err error) {
 	if id != "" {
 		return usersAPI.read(id)
 	}
-	userList, err := usersAPI.Filter(fmt.Sprintf("userName eq '%s'", name))
+	userList, err := usersAPI.List(ListRequest{
+		Filter:     fmt.Sprintf("userName eq '%s'", name),
+		Attributes: "id,userName,displayName,externalId",
+	})
 	if err != nil {
 		return
 	}
@@ -15,7 +15,7 @@ func TestDataSourceUser(t *testing.T) {
 		Fixtures: []qa.HTTPFixture{
 			{
 				Method:   "GET",
-				Resource: "/api/2.0/preview/scim/v2/Users?filter=userName%20eq%20%27ds%27",
+				Resource: "/api/2.0/preview/scim/v2/Users?attributes=id%2CuserName%2CdisplayName%2CexternalId&count=100&filter=userName+eq+%27ds%27&startIndex=1",
 				Response: UserList{
 					Resources: []ScimUser{
 						{
@@ -52,7 +52,7 @@ func TestDataSourceUserGerUser(t *testing.T) {
 		},
 		{
 			Method:   "GET",
-			Resource: "/api/2.0/preview/scim/v2/Users?filter=userName%20eq%20%27searching_error%27",
+			Resource: "/api/2.0/preview/scim/v2/Users?attributes=id%2CuserName%2CdisplayName%2CexternalId&count=100&filter=userName+eq+%27searching_error%27&startIndex=1",
 			Status:   404,
 			Response: common.APIError{
 				Message: "searching_error",
@@ -60,7 +60,7 @@ func TestDataSourceUserGerUser(t *testing.T) {
 		},
 		{
 			Method:   "GET",
-			Resource: "/api/2.0/preview/scim/v2/Users?filter=userName%20eq%20%27empty_search%27",
+			Resource: "/api/2.0/preview/scim/v2/Users?attributes=id%2CuserName%2CdisplayName%2CexternalId&count=100&filter=userName+eq+%27empty_search%27&startIndex=1",
 			Response: UserList{},
 		},
 	}, func(ctx context.Context, client *common.DatabricksClient) {
@@ -0,0 +1,73 @@
+package identity
+
+import (
+	"context"
+	"crypto/md5"
+	"fmt"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// UserSummary is the subset of user attributes exposed by listing data sources
+type UserSummary struct {
+	ID          string `json:"id,omitempty"`
+	UserName    string `json:"user_name,omitempty"`
+	DisplayName string `json:"display_name,omitempty"`
+	ExternalID  string `json:"external_id,omitempty"`
+	Active      bool   `json:"active,omitempty"`
+}
+
+// filterID derives stable data source identifier from SCIM filter expression
+func filterID(prefix, filter string) string {
+	return fmt.Sprintf("%s-%x", prefix, md5.Sum([]byte(filter)))
+}
+
+// DataSourceUsers returns users matching SCIM filter expression
+func DataSourceUsers() *schema.Resource {
+	type usersFilter struct {
+		Filter string        `json:"filter,omitempty"`
+		IDs    []string      `json:"ids,omitempty" tf:"computed,slice_set"`
+		Users  []UserSummary `json:"users,omitempty" tf:"computed"`
+	}
+	s := common.StructToSchema(usersFilter{}, func(
+		s map[string]*schema.Schema) map[string]*schema.Schema {
+		return s
+	})
+	return &schema.Resource{
+		Schema: s,
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			var this usersFilter
+			err := common.DataToStructPointer(d, s, &this)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			this.IDs = []string{}
+			this.Users = []UserSummary{}
+			err = NewUsersAPI(ctx, m).ForEach(ListRequest{
+				Filter:     this.Filter,
+				Attributes: "id,userName,displayName,externalId,active",
+			}, func(u ScimUser) error {
+				this.IDs = append(this.IDs, u.ID)
+				this.Users = append(this.Users, UserSummary{
+					ID:          u.ID,
+					UserName:    u.UserName,
+					DisplayName: u.DisplayName,
+					ExternalID:  u.ExternalID,
+					Active:      u.Active,
+				})
+				return nil
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			d.SetId(filterID("users", this.Filter))
+			err = common.StructToData(this, s, d)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,66 @@
+package identity
+
+import (
+	"testing"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+	"github.com/databrickslabs/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataSourceUsers(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method: "GET",
+				Resource: "/api/2.0/preview/scim/v2/Users?attributes=id%2CuserName%2CdisplayName%2CexternalId%2Cactive" +
+					"&count=100&filter=userName+sw+%27data%27&startIndex=1",
+				Response: UserList{
+					TotalResults: 2,
+					Resources: []ScimUser{
+						{
+							ID:          "123",
+							UserName:    "data.engineer@example.com",
+							DisplayName: "Data Engineer",
+							Active:      true,
+						},
+						{
+							ID:       "456",
+							UserName: "data.scientist@example.com",
+						},
+					},
+				},
+			},
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceUsers(),
+		ID:          ".",
+		HCL:         `filter = "userName sw 'data'"`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, 2, d.Get("ids.#"))
+	assert.Equal(t, "data.engineer@example.com", d.Get("users.0.user_name"))
+	assert.Equal(t, true, d.Get("users.0.active"))
+	assert.Equal(t, "456", d.Get("users.1.id"))
+}
+
+func TestDataSourceUsers_Error(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/scim/v2/Users?attributes=id%2CuserName%2CdisplayName%2CexternalId%2Cactive&count=100&startIndex=1",
+				Status:   400,
+				Response: common.APIErrorBody{
+					ErrorCode: "INVALID_PARAMETER_VALUE",
+					Message:   "Invalid filter",
+				},
+			},
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceUsers(),
+		ID:          ".",
+	}.ExpectError(t, "Invalid filter")
+}
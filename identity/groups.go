@@ -38,15 +38,40 @@ func (a GroupsAPI) Read(groupID string) (group ScimGroup, err error) {
 	return
 }
 
+// ForEach calls callback for every group matching the request, fetching them page by page
+func (a GroupsAPI) ForEach(req ListRequest, callback func(ScimGroup) error) error {
+	return listPages(req, func(page ListRequest) (int, int, error) {
+		var groups GroupList
+		err := a.client.Scim(a.context, http.MethodGet, "/preview/scim/v2/Groups", page, &groups)
+		if err != nil {
+			return 0, 0, err
+		}
+		for _, g := range groups.Resources {
+			if err = callback(g); err != nil {
+				return 0, 0, err
+			}
+		}
+		return len(groups.Resources), int(groups.TotalResults), nil
+	})
+}
+
+// List returns all groups matching the request
+func (a GroupsAPI) List(req ListRequest) (groups []ScimGroup, err error) {
+	groups = []ScimGroup{}
+	err = a.ForEach(req, func(g ScimGroup) error {
+		groups = append(groups, g)
+		return nil
+	})
+	return
+}
+
 // Filter returns groups matching the filter
 func (a GroupsAPI) Filter(filter string) (GroupList, error) {
-	var groups GroupList
-	req := map[string]string{}
-	if filter != "" {
-		req["filter"] = filter
-	}
-	err := a.client.Scim(a.context, http.MethodGet, "/preview/scim/v2/Groups", req, &groups)
-	return groups, err
+	groups, err := a.List(ListRequest{Filter: filter})
+	return GroupList{
+		TotalResults: int32(len(groups)),
+		Resources:    groups,
+	}, err
 }
 
 func (a GroupsAPI) ReadByDisplayName(displayName string) (group ScimGroup, err error) {
@@ -66,6 +91,59 @@ func (a GroupsAPI) Patch(groupID string, r patchRequest) error {
 	return a.client.Scim(a.context, http.MethodPatch, fmt.Sprintf("/preview/scim/v2/Groups/%v", groupID), r, nil)
 }
 
+// ReadMembers returns only members of a group, which is cheaper for groups with large memberships.
+// Members are fetched page by page, as large groups don't fit into a single response.
+func (a GroupsAPI) ReadMembers(groupID string) ([]ComplexValue, error) {
+	members := []ComplexValue{}
+	seen := map[string]bool{}
+	err := listPages(ListRequest{Attributes: "members"}, func(page ListRequest) (int, int, error) {
+		var group ScimGroup
+		err := a.client.Scim(a.context, http.MethodGet,
+			fmt.Sprintf("/preview/scim/v2/Groups/%v", groupID), page, &group)
+		if err != nil {
+			return 0, 0, err
+		}
+		received := 0
+		for _, m := range group.Members {
+			if seen[m.Value] {
+				// the page was already received
+				continue
+			}
+			seen[m.Value] = true
+			members = append(members, m)
+			received++
+		}
+		// group doesn't report the total number of members, so the last page is the one,
+		// that isn't full
+		total := page.StartIndex + received
+		if len(group.Members) < page.Count {
+			total--
+		}
+		return received, total, nil
+	})
+	return members, err
+}
+
+// ReadWithoutMembers returns a group with its parent groups, roles and entitlements, but without members
+func (a GroupsAPI) ReadWithoutMembers(groupID string) (group ScimGroup, err error) {
+	err = a.client.Scim(a.context, http.MethodGet, fmt.Sprintf("/preview/scim/v2/Groups/%v", groupID),
+		map[string]string{
+			"attributes": "id,displayName,groups,roles,entitlements",
+		}, &group)
+	return
+}
+
+// PatchMembers adds and removes group members in batches of PatchOp operations
+func (a GroupsAPI) PatchMembers(groupID string, add, remove []string) error {
+	for _, r := range membersPatchRequests(add, remove) {
+		err := a.Patch(groupID, r)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (a GroupsAPI) UpdateNameAndEntitlements(groupID string, name string, externalID string, e entitlements) error {
 	g, err := a.Read(groupID)
 	if err != nil {
@@ -0,0 +1,162 @@
+package identity
+
+import (
+	"context"
+	"sort"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// GroupMembers is the set of members of a single group
+type GroupMembers struct {
+	GroupID       string   `json:"group_id" tf:"force_new"`
+	Members       []string `json:"members,omitempty" tf:"slice_set"`
+	Authoritative bool     `json:"authoritative,omitempty"`
+}
+
+// difference returns sorted items of a, that are not in b
+func difference(a []string, b map[string]bool) []string {
+	result := []string{}
+	for _, v := range a {
+		if !b[v] {
+			result = append(result, v)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+func toSet(items []string) map[string]bool {
+	set := map[string]bool{}
+	for _, v := range items {
+		set[v] = true
+	}
+	return set
+}
+
+func readMemberIDs(groupsAPI GroupsAPI, groupID string) (map[string]bool, error) {
+	members, err := groupsAPI.ReadMembers(groupID)
+	if err != nil {
+		return nil, err
+	}
+	ids := map[string]bool{}
+	for _, m := range members {
+		ids[m.Value] = true
+	}
+	return ids, nil
+}
+
+// ResourceGroupMembers manages all members of a group with batched SCIM PATCH requests
+func ResourceGroupMembers() *schema.Resource {
+	s := common.StructToSchema(GroupMembers{}, func(
+		s map[string]*schema.Schema) map[string]*schema.Schema {
+		return s
+	})
+	// apply sets declared members and, in authoritative mode, removes undeclared ones.
+	// Previously declared members are removed regardless of the mode.
+	apply := func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+		var gm GroupMembers
+		if err := common.DataToStructPointer(d, s, &gm); err != nil {
+			return err
+		}
+		// removed elements of `members` set may come back as empty strings
+		gm.Members = difference(gm.Members, map[string]bool{"": true})
+		groupsAPI := NewGroupsAPI(ctx, c)
+		actual, err := readMemberIDs(groupsAPI, gm.GroupID)
+		if err != nil {
+			return err
+		}
+		declared := toSet(gm.Members)
+		add := difference(gm.Members, actual)
+		var remove []string
+		if gm.Authoritative {
+			remove = difference(keys(actual), declared)
+		} else {
+			old, _ := d.GetChange("members")
+			removed := difference(setToStrings(old), declared)
+			remove = []string{}
+			for _, v := range removed {
+				if actual[v] {
+					remove = append(remove, v)
+				}
+			}
+		}
+		if err = groupsAPI.PatchMembers(gm.GroupID, add, remove); err != nil {
+			return err
+		}
+		d.SetId(gm.GroupID)
+		return nil
+	}
+	return common.Resource{
+		Schema: s,
+		Create: apply,
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			actual, err := readMemberIDs(NewGroupsAPI(ctx, c), d.Id())
+			if err != nil {
+				return err
+			}
+			gm := GroupMembers{
+				GroupID:       d.Id(),
+				Authoritative: d.Get("authoritative").(bool),
+				Members:       []string{},
+			}
+			if gm.Authoritative || d.Get("group_id").(string) == "" {
+				// imported groups have all of their members declared
+				gm.Members = keys(actual)
+			} else {
+				for _, v := range setToStrings(d.Get("members")) {
+					if actual[v] {
+						gm.Members = append(gm.Members, v)
+					}
+				}
+			}
+			if len(gm.Members) == 0 {
+				// empty lists are skipped by StructToData
+				d.Set("members", []string{})
+			}
+			return common.StructToData(gm, s, d)
+		},
+		Update: apply,
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var gm GroupMembers
+			if err := common.DataToStructPointer(d, s, &gm); err != nil {
+				return err
+			}
+			groupsAPI := NewGroupsAPI(ctx, c)
+			actual, err := readMemberIDs(groupsAPI, gm.GroupID)
+			if err != nil {
+				return err
+			}
+			remove := []string{}
+			for _, v := range gm.Members {
+				if actual[v] {
+					remove = append(remove, v)
+				}
+			}
+			sort.Strings(remove)
+			return groupsAPI.PatchMembers(gm.GroupID, nil, remove)
+		},
+	}.ToResource()
+}
+
+func keys(set map[string]bool) []string {
+	result := []string{}
+	for k := range set {
+		result = append(result, k)
+	}
+	sort.Strings(result)
+	return result
+}
+
+func setToStrings(raw interface{}) []string {
+	result := []string{}
+	set, ok := raw.(*schema.Set)
+	if !ok {
+		return result
+	}
+	for _, v := range set.List() {
+		result = append(result, v.(string))
+	}
+	return result
+}
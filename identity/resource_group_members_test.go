@@ -0,0 +1,262 @@
+package identity
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+	"github.com/databrickslabs/terraform-provider-databricks/qa"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func groupMembersFixture(members ...string) qa.HTTPFixture {
+	group := ScimGroup{ID: "abc"}
+	for _, m := range members {
+		group.Members = append(group.Members, ComplexValue{Value: m})
+	}
+	return qa.HTTPFixture{
+		Method:   "GET",
+		Resource: "/api/2.0/preview/scim/v2/Groups/abc?attributes=members&count=100&startIndex=1",
+		Response: group,
+	}
+}
+
+func addMembersRequest(members ...string) patchRequest {
+	values := []ComplexValue{}
+	for _, m := range members {
+		values = append(values, ComplexValue{Value: m})
+	}
+	return patchRequest{
+		Schemas: []URN{PatchOp},
+		Operations: []patchOperation{
+			{Op: "add", Path: "members", Value: values},
+		},
+	}
+}
+
+func removeMembersRequest(members ...string) patchRequest {
+	r := patchRequest{Schemas: []URN{PatchOp}}
+	for _, m := range members {
+		r.Operations = append(r.Operations, patchOperation{
+			Op:   "remove",
+			Path: fmt.Sprintf(`members[value eq "%s"]`, m),
+		})
+	}
+	return r
+}
+
+func TestMembersPatchRequests_Batches(t *testing.T) {
+	add := []string{}
+	for i := 0; i < 250; i++ {
+		add = append(add, fmt.Sprintf("u%d", i))
+	}
+	requests := membersPatchRequests(add, []string{"x", "y"})
+	assert.Len(t, requests, 4)
+	assert.Len(t, requests[0].Operations[0].Value, 100)
+	assert.Len(t, requests[2].Operations[0].Value, 50)
+	assert.Equal(t, removeMembersRequest("x", "y"), requests[3])
+	assert.Len(t, membersPatchRequests(nil, nil), 0)
+}
+
+func TestResourceGroupMembersCreate(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			groupMembersFixture("a", "z"),
+			{
+				Method:          "PATCH",
+				Resource:        "/api/2.0/preview/scim/v2/Groups/abc",
+				ExpectedRequest: addMembersRequest("b", "c"),
+			},
+			groupMembersFixture("a", "b", "c", "z"),
+		},
+		Resource: ResourceGroupMembers(),
+		Create:   true,
+		HCL: `group_id = "abc"
+		members = ["a", "b", "c"]`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "abc", d.Id())
+	assert.Equal(t, 3, d.Get("members.#"))
+}
+
+func TestResourceGroupMembersCreate_Authoritative(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			groupMembersFixture("a", "y", "z"),
+			{
+				Method:          "PATCH",
+				Resource:        "/api/2.0/preview/scim/v2/Groups/abc",
+				ExpectedRequest: addMembersRequest("b"),
+			},
+			{
+				Method:          "PATCH",
+				Resource:        "/api/2.0/preview/scim/v2/Groups/abc",
+				ExpectedRequest: removeMembersRequest("y", "z"),
+			},
+			groupMembersFixture("a", "b"),
+		},
+		Resource: ResourceGroupMembers(),
+		Create:   true,
+		HCL: `group_id = "abc"
+		members = ["a", "b"]
+		authoritative = true`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, 2, d.Get("members.#"))
+}
+
+func TestResourceGroupMembersRead(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			groupMembersFixture("a", "z"),
+		},
+		Resource: ResourceGroupMembers(),
+		Read:     true,
+		ID:       "abc",
+		HCL: `group_id = "abc"
+		members = ["a", "b"]`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, []interface{}{"a"}, d.Get("members").(*schema.Set).List())
+}
+
+func TestResourceGroupMembersRead_Import(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			groupMembersFixture("a", "z"),
+		},
+		Resource: ResourceGroupMembers(),
+		Read:     true,
+		New:      true,
+		ID:       "abc",
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "abc", d.Get("group_id"))
+	assert.Equal(t, 2, d.Get("members.#"))
+}
+
+func TestResourceGroupMembersRead_NotFound(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/scim/v2/Groups/abc?attributes=members&count=100&startIndex=1",
+				Status:   404,
+				Response: map[string]string{
+					"detail": "Group with id abc not found.",
+				},
+			},
+		},
+		Resource: ResourceGroupMembers(),
+		Read:     true,
+		Removed:  true,
+		ID:       "abc",
+	}.ApplyNoError(t)
+}
+
+func TestResourceGroupMembersUpdate(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			groupMembersFixture("a", "b", "z"),
+			{
+				Method:          "PATCH",
+				Resource:        "/api/2.0/preview/scim/v2/Groups/abc",
+				ExpectedRequest: addMembersRequest("c"),
+			},
+			{
+				Method:          "PATCH",
+				Resource:        "/api/2.0/preview/scim/v2/Groups/abc",
+				ExpectedRequest: removeMembersRequest("b"),
+			},
+			groupMembersFixture("a", "c", "z"),
+		},
+		Resource: ResourceGroupMembers(),
+		Update:   true,
+		ID:       "abc",
+		InstanceState: map[string]string{
+			"group_id":           "abc",
+			"members.#":          "2",
+			"members.3904355907": "a",
+			"members.1908338681": "b",
+		},
+		HCL: `group_id = "abc"
+		members = ["a", "c"]`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, 2, d.Get("members.#"))
+}
+
+func TestResourceGroupMembersDelete(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			groupMembersFixture("a", "z"),
+			{
+				Method:          "PATCH",
+				Resource:        "/api/2.0/preview/scim/v2/Groups/abc",
+				ExpectedRequest: removeMembersRequest("a"),
+			},
+		},
+		Resource: ResourceGroupMembers(),
+		Delete:   true,
+		ID:       "abc",
+		HCL: `group_id = "abc"
+		members = ["a", "b"]`,
+	}.ApplyNoError(t)
+}
+
+func TestResourceGroupMembers_CornerCases(t *testing.T) {
+	qa.ResourceCornerCases(t, ResourceGroupMembers())
+}
+
+func TestGroupsAPIReadMembers_Pages(t *testing.T) {
+	firstPage := ScimGroup{ID: "abc"}
+	for i := 0; i < 100; i++ {
+		firstPage.Members = append(firstPage.Members, ComplexValue{Value: fmt.Sprintf("u%d", i)})
+	}
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/preview/scim/v2/Groups/abc?attributes=members&count=100&startIndex=1",
+			Response: firstPage,
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/preview/scim/v2/Groups/abc?attributes=members&count=100&startIndex=101",
+			Response: ScimGroup{
+				ID:      "abc",
+				Members: []ComplexValue{{Value: "u100"}},
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		members, err := NewGroupsAPI(ctx, client).ReadMembers("abc")
+		require.NoError(t, err)
+		assert.Len(t, members, 101)
+		assert.Equal(t, "u100", members[100].Value)
+	})
+}
+
+func TestGroupsAPIReadMembers_PaginationIgnored(t *testing.T) {
+	group := ScimGroup{ID: "abc"}
+	for i := 0; i < 100; i++ {
+		group.Members = append(group.Members, ComplexValue{Value: fmt.Sprintf("u%d", i)})
+	}
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/preview/scim/v2/Groups/abc?attributes=members&count=100&startIndex=1",
+			Response: group,
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/preview/scim/v2/Groups/abc?attributes=members&count=100&startIndex=101",
+			Response: group,
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		members, err := NewGroupsAPI(ctx, client).ReadMembers("abc")
+		require.NoError(t, err)
+		assert.Len(t, members, 100)
+	})
+}
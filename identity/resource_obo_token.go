@@ -9,9 +9,9 @@ import (
 )
 
 type OboToken struct {
-	ApplicationID   string `json:"application_id"`
-	LifetimeSeconds int32  `json:"lifetime_seconds"`
-	Comment         string `json:"comment"`
+	ApplicationID   string `json:"application_id" tf:"force_new"`
+	LifetimeSeconds int32  `json:"lifetime_seconds" tf:"force_new"`
+	Comment         string `json:"comment" tf:"force_new"`
 }
 
 func NewTokenManagementAPI(ctx context.Context, m interface{}) TokenManagementAPI {
@@ -28,10 +28,19 @@ func (a TokenManagementAPI) CreateTokenOnBehalfOfServicePrincipal(request OboTok
 	return
 }
 
+// Delete revokes token of any user or service principal
 func (a TokenManagementAPI) Delete(tokenID string) error {
 	return a.client.Delete(a.context, fmt.Sprintf("/token-management/tokens/%s", tokenID), map[string]interface{}{})
 }
 
+func (a TokenManagementAPI) deleteIfExists(tokenID string) error {
+	err := a.Delete(tokenID)
+	if common.IsMissing(err) {
+		return nil
+	}
+	return err
+}
+
 func (a TokenManagementAPI) Read(tokenID string) (ti TokenResponse, err error) {
 	err = a.client.Get(a.context, fmt.Sprintf("/token-management/tokens/%s", tokenID), nil, &ti)
 	return
@@ -45,16 +54,25 @@ func ResourceOboToken() *schema.Resource {
 				Computed:  true,
 				Sensitive: true,
 			}
+			m["creation_time"] = &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			}
+			addTokenRotationToSchema(m)
 			return m
 		})
+	create := func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) (TokenResponse, error) {
+		var request OboToken
+		if err := common.DataToStructPointer(d, oboTokenSchema, &request); err != nil {
+			return TokenResponse{}, err
+		}
+		return NewTokenManagementAPI(ctx, c).CreateTokenOnBehalfOfServicePrincipal(request)
+	}
 	return common.Resource{
-		Schema: oboTokenSchema,
+		Schema:        oboTokenSchema,
+		CustomizeDiff: customizeTokenRotationDiff,
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
-			var request OboToken
-			if err := common.DataToStructPointer(d, oboTokenSchema, &request); err != nil {
-				return err
-			}
-			ot, err := NewTokenManagementAPI(ctx, c).CreateTokenOnBehalfOfServicePrincipal(request)
+			ot, err := create(ctx, d, c)
 			if err != nil {
 				return err
 			}
@@ -66,11 +84,27 @@ func ResourceOboToken() *schema.Resource {
 			if err != nil {
 				return err
 			}
+			if ot.TokenInfo == nil {
+				return common.NotFound(fmt.Sprintf("token %s not found", d.Id()))
+			}
 			// this method is just a shim to check if token does still exist
+			d.Set("creation_time", ot.TokenInfo.CreationTime)
 			return d.Set("comment", ot.TokenInfo.Comment)
 		},
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			tokenManagementAPI := NewTokenManagementAPI(ctx, c)
+			return rotateToken(d, func() (TokenResponse, error) {
+				return create(ctx, d, c)
+			}, tokenManagementAPI.deleteIfExists)
+		},
 		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
-			return NewTokenManagementAPI(ctx, c).Delete(d.Id())
+			tokenManagementAPI := NewTokenManagementAPI(ctx, c)
+			if previousID := d.Get("previous_token_id").(string); previousID != "" {
+				if err := tokenManagementAPI.deleteIfExists(previousID); err != nil {
+					return err
+				}
+			}
+			return tokenManagementAPI.Delete(d.Id())
 		},
 	}.ToResource()
 }
@@ -2,8 +2,10 @@ package identity
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/databrickslabs/terraform-provider-databricks/common"
 	"github.com/databrickslabs/terraform-provider-databricks/qa"
@@ -177,3 +179,134 @@ func TestResourceOboTokenDelete(t *testing.T) {
 		ID:       "abc",
 	}.ApplyNoError(t)
 }
+
+func TestResourceOboTokenUpdate_Rotates(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/token-management/on-behalf-of/tokens",
+				ExpectedRequest: OboToken{
+					ApplicationID:   "abc",
+					LifetimeSeconds: 3600,
+					Comment:         "e",
+				},
+				Response: TokenResponse{
+					TokenValue: "new",
+					TokenInfo: &TokenInfo{
+						TokenID: "third",
+					},
+				},
+			},
+			{
+				Method:   "DELETE",
+				Resource: "/api/2.0/token-management/tokens/first",
+				Status:   404,
+				Response: common.APIErrorBody{
+					ErrorCode: "RESOURCE_DOES_NOT_EXIST",
+					Message:   "Token first does not exist",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/token-management/tokens/third",
+				Response: TokenResponse{
+					TokenInfo: &TokenInfo{
+						TokenID:      "third",
+						Comment:      "e",
+						CreationTime: time.Now().UnixNano() / int64(time.Millisecond),
+					},
+				},
+			},
+		},
+		Resource: ResourceOboToken(),
+		Update:   true,
+		ID:       "second",
+		InstanceState: map[string]string{
+			"application_id":       "abc",
+			"lifetime_seconds":     "3600",
+			"comment":              "e",
+			"creation_time":        "10",
+			"rotation_days":        "30",
+			"token_value":          "old",
+			"previous_token_id":    "first",
+			"previous_token_value": "older",
+		},
+		HCL: `
+		application_id = "abc"
+		lifetime_seconds = 3600
+		comment = "e"
+		rotation_days = 30
+		`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "third", d.Id())
+	assert.Equal(t, "new", d.Get("token_value"))
+	assert.Equal(t, "second", d.Get("previous_token_id"))
+	assert.Equal(t, "old", d.Get("previous_token_value"))
+}
+
+func TestResourceOboTokenUpdate_NotDue(t *testing.T) {
+	yesterday := time.Now().Add(-24*time.Hour).UnixNano() / int64(time.Millisecond)
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/token-management/tokens/abc",
+				Response: TokenResponse{
+					TokenInfo: &TokenInfo{
+						TokenID:      "abc",
+						Comment:      "e",
+						CreationTime: yesterday,
+					},
+				},
+			},
+		},
+		Resource: ResourceOboToken(),
+		Update:   true,
+		ID:       "abc",
+		InstanceState: map[string]string{
+			"application_id":   "abc",
+			"lifetime_seconds": "3600",
+			"comment":          "e",
+			"creation_time":    fmt.Sprint(yesterday),
+			"token_value":      "old",
+		},
+		HCL: `
+		application_id = "abc"
+		lifetime_seconds = 3600
+		comment = "e"
+		rotation_days = 30
+		`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "abc", d.Id())
+	assert.Equal(t, "old", d.Get("token_value"))
+}
+
+func TestResourceOboTokenDelete_WithPrevious(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "DELETE",
+				Resource: "/api/2.0/token-management/tokens/first",
+			},
+			{
+				Method:   "DELETE",
+				Resource: "/api/2.0/token-management/tokens/second",
+			},
+		},
+		Resource: ResourceOboToken(),
+		Delete:   true,
+		ID:       "second",
+		InstanceState: map[string]string{
+			"application_id":    "abc",
+			"lifetime_seconds":  "3600",
+			"comment":           "e",
+			"previous_token_id": "first",
+		},
+		HCL: `application_id = "abc"
+		lifetime_seconds = 3600
+		comment = "e"`,
+	}.ApplyNoError(t)
+}
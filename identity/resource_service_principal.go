@@ -35,6 +35,33 @@ func (a ServicePrincipalsAPI) read(servicePrincipalID string) (sp ScimUser, err
 	return
 }
 
+// ForEach calls callback for every service principal matching the request, fetching them page by page
+func (a ServicePrincipalsAPI) ForEach(req ListRequest, callback func(ScimUser) error) error {
+	return listPages(req, func(page ListRequest) (int, int, error) {
+		var sps UserList
+		err := a.client.Scim(a.context, "GET", "/preview/scim/v2/ServicePrincipals", page, &sps)
+		if err != nil {
+			return 0, 0, err
+		}
+		for _, sp := range sps.Resources {
+			if err = callback(sp); err != nil {
+				return 0, 0, err
+			}
+		}
+		return len(sps.Resources), int(sps.TotalResults), nil
+	})
+}
+
+// List returns all service principals matching the request
+func (a ServicePrincipalsAPI) List(req ListRequest) (sps []ScimUser, err error) {
+	sps = []ScimUser{}
+	err = a.ForEach(req, func(sp ScimUser) error {
+		sps = append(sps, sp)
+		return nil
+	})
+	return
+}
+
 // Update replaces resource-friendly-entity
 func (a ServicePrincipalsAPI) Update(servicePrincipalID string, updateRequest ScimUser) error {
 	servicePrincipal, err := a.read(servicePrincipalID)
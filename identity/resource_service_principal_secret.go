@@ -0,0 +1,120 @@
+package identity
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ServicePrincipalSecret is an OAuth secret of a service principal
+type ServicePrincipalSecret struct {
+	ID         string `json:"id,omitempty"`
+	Secret     string `json:"secret,omitempty"`
+	Status     string `json:"status,omitempty"`
+	CreateTime string `json:"create_time,omitempty"`
+	UpdateTime string `json:"update_time,omitempty"`
+}
+
+// ServicePrincipalSecretList is a response from listing secrets of a service principal
+type ServicePrincipalSecretList struct {
+	Secrets []ServicePrincipalSecret `json:"secrets,omitempty"`
+}
+
+// NewServicePrincipalSecretsAPI creates ServicePrincipalSecretsAPI instance from provider meta
+func NewServicePrincipalSecretsAPI(ctx context.Context, m interface{}) ServicePrincipalSecretsAPI {
+	return ServicePrincipalSecretsAPI{m.(*common.DatabricksClient), ctx}
+}
+
+// ServicePrincipalSecretsAPI exposes account-level OAuth secrets of service principals
+type ServicePrincipalSecretsAPI struct {
+	client  *common.DatabricksClient
+	context context.Context
+}
+
+func (a ServicePrincipalSecretsAPI) path(servicePrincipalID string) (string, error) {
+	if a.client.AccountID == "" {
+		return "", fmt.Errorf("provider must be configured with account_id to manage service principal secrets")
+	}
+	return fmt.Sprintf("/accounts/%s/servicePrincipals/%s/credentials/secrets",
+		a.client.AccountID, servicePrincipalID), nil
+}
+
+// Create generates new secret. Secret value is returned only once
+func (a ServicePrincipalSecretsAPI) Create(servicePrincipalID string) (secret ServicePrincipalSecret, err error) {
+	path, err := a.path(servicePrincipalID)
+	if err != nil {
+		return
+	}
+	err = a.client.Post(a.context, path, map[string]string{}, &secret)
+	return
+}
+
+// List returns secrets of a service principal without their values
+func (a ServicePrincipalSecretsAPI) List(servicePrincipalID string) ([]ServicePrincipalSecret, error) {
+	var secrets ServicePrincipalSecretList
+	path, err := a.path(servicePrincipalID)
+	if err != nil {
+		return nil, err
+	}
+	err = a.client.Get(a.context, path, nil, &secrets)
+	return secrets.Secrets, err
+}
+
+// Delete revokes secret of a service principal
+func (a ServicePrincipalSecretsAPI) Delete(servicePrincipalID, secretID string) error {
+	path, err := a.path(servicePrincipalID)
+	if err != nil {
+		return err
+	}
+	return a.client.Delete(a.context, fmt.Sprintf("%s/%s", path, secretID), nil)
+}
+
+// ResourceServicePrincipalSecret manages OAuth secrets of service principals
+func ResourceServicePrincipalSecret() *schema.Resource {
+	type entity struct {
+		ServicePrincipalID string `json:"service_principal_id"`
+		Secret             string `json:"secret,omitempty" tf:"computed"`
+		Status             string `json:"status,omitempty" tf:"computed"`
+		CreateTime         string `json:"create_time,omitempty" tf:"computed"`
+	}
+	s := common.StructToSchema(entity{}, func(
+		m map[string]*schema.Schema) map[string]*schema.Schema {
+		m["secret"].Sensitive = true
+		return m
+	})
+	return common.Resource{
+		Schema: s,
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			spID := d.Get("service_principal_id").(string)
+			secret, err := NewServicePrincipalSecretsAPI(ctx, c).Create(spID)
+			if err != nil {
+				return err
+			}
+			d.SetId(secret.ID)
+			// value is returned only on creation
+			return d.Set("secret", secret.Secret)
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			spID := d.Get("service_principal_id").(string)
+			secrets, err := NewServicePrincipalSecretsAPI(ctx, c).List(spID)
+			if err != nil {
+				return err
+			}
+			for _, secret := range secrets {
+				if secret.ID != d.Id() {
+					continue
+				}
+				d.Set("status", secret.Status)
+				return d.Set("create_time", secret.CreateTime)
+			}
+			return common.NotFound(fmt.Sprintf("secret %s of service principal %s not found",
+				d.Id(), spID))
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			return NewServicePrincipalSecretsAPI(ctx, c).Delete(
+				d.Get("service_principal_id").(string), d.Id())
+		},
+	}.ToResource()
+}
@@ -0,0 +1,88 @@
+package identity
+
+import (
+	"testing"
+
+	"github.com/databrickslabs/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceServicePrincipalSecretCreate(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/accounts/acc/servicePrincipals/123/credentials/secrets",
+				Response: ServicePrincipalSecret{
+					ID:     "s1",
+					Secret: "dose...",
+					Status: "ACTIVE",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/accounts/acc/servicePrincipals/123/credentials/secrets",
+				Response: ServicePrincipalSecretList{
+					Secrets: []ServicePrincipalSecret{
+						{
+							ID:         "s1",
+							Status:     "ACTIVE",
+							CreateTime: "2021-11-01T10:00:00Z",
+						},
+					},
+				},
+			},
+		},
+		Resource:  ResourceServicePrincipalSecret(),
+		AccountID: "acc",
+		Create:    true,
+		HCL:       `service_principal_id = "123"`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "s1", d.Id())
+	assert.Equal(t, "dose...", d.Get("secret"))
+	assert.Equal(t, "ACTIVE", d.Get("status"))
+	assert.Equal(t, "2021-11-01T10:00:00Z", d.Get("create_time"))
+}
+
+func TestResourceServicePrincipalSecretCreate_NoAccountID(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceServicePrincipalSecret(),
+		Create:   true,
+		HCL:      `service_principal_id = "123"`,
+	}.ExpectError(t, "provider must be configured with account_id to manage service principal secrets")
+}
+
+func TestResourceServicePrincipalSecretRead_NotFound(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/accounts/acc/servicePrincipals/123/credentials/secrets",
+				Response: ServicePrincipalSecretList{},
+			},
+		},
+		Resource:  ResourceServicePrincipalSecret(),
+		AccountID: "acc",
+		Read:      true,
+		Removed:   true,
+		ID:        "s1",
+		HCL:       `service_principal_id = "123"`,
+	}.ApplyNoError(t)
+}
+
+func TestResourceServicePrincipalSecretDelete(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "DELETE",
+				Resource: "/api/2.0/accounts/acc/servicePrincipals/123/credentials/secrets/s1",
+			},
+		},
+		Resource:  ResourceServicePrincipalSecret(),
+		AccountID: "acc",
+		Delete:    true,
+		ID:        "s1",
+		HCL:       `service_principal_id = "123"`,
+	}.ApplyNoError(t)
+}
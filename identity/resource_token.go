@@ -3,12 +3,14 @@ package identity
 import (
 	"context"
 	"fmt"
+	"log"
 	"net/http"
 	"time"
 
 	"github.com/databrickslabs/terraform-provider-databricks/common"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 // TokenRequest asks for a token
@@ -100,6 +102,74 @@ func (a TokensAPI) Delete(tokenID string) error {
 	return err
 }
 
+// addTokenRotationToSchema adds attributes, that keep previous token valid during the rotation overlap
+func addTokenRotationToSchema(s map[string]*schema.Schema) {
+	s["rotation_days"] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntAtLeast(1),
+	}
+	s["previous_token_id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+	s["previous_token_value"] = &schema.Schema{
+		Type:      schema.TypeString,
+		Computed:  true,
+		Sensitive: true,
+	}
+}
+
+// tokenRotationDue returns true if token created at creationTime (epoch milliseconds)
+// is older than rotationDays
+func tokenRotationDue(creationTime int64, rotationDays int) bool {
+	if rotationDays <= 0 || creationTime <= 0 {
+		return false
+	}
+	age := time.Since(time.Unix(0, creationTime*int64(time.Millisecond)))
+	return age.Hours() >= float64(rotationDays*24)
+}
+
+// customizeTokenRotationDiff plans the new token value, once token gets older than rotation_days
+func customizeTokenRotationDiff(ctx context.Context, d *schema.ResourceDiff, c interface{}) error {
+	// new tokens have no creation_time yet
+	if !tokenRotationDue(int64(d.Get("creation_time").(int)), d.Get("rotation_days").(int)) {
+		return nil
+	}
+	log.Printf("[INFO] Token %s is older than %d days and will be rotated",
+		d.Id(), d.Get("rotation_days").(int))
+	for _, k := range []string{"token_value", "previous_token_id", "previous_token_value"} {
+		if err := d.SetNewComputed(k); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// rotateToken creates new token before revoking the one, that was replaced by the previous rotation.
+// Replaced token remains valid until the next rotation, so that consumers have time to pick up new value.
+func rotateToken(d *schema.ResourceData, create func() (TokenResponse, error),
+	revoke func(tokenID string) error) error {
+	if !tokenRotationDue(int64(d.Get("creation_time").(int)), d.Get("rotation_days").(int)) {
+		return nil
+	}
+	oldValue, _ := d.GetChange("token_value")
+	oldPreviousID, _ := d.GetChange("previous_token_id")
+	tr, err := create()
+	if err != nil {
+		return err
+	}
+	if oldPreviousID.(string) != "" {
+		if err = revoke(oldPreviousID.(string)); err != nil {
+			return err
+		}
+	}
+	d.Set("previous_token_id", d.Id())
+	d.Set("previous_token_value", oldValue)
+	d.SetId(tr.TokenInfo.TokenID)
+	return d.Set("token_value", tr.TokenValue)
+}
+
 // ResourceToken refreshes token in case it's expired
 func ResourceToken() *schema.Resource {
 	s := map[string]*schema.Schema{
@@ -134,13 +204,17 @@ func ResourceToken() *schema.Resource {
 			Computed: true,
 		},
 	}
+	addTokenRotationToSchema(s)
+	create := func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) (TokenResponse, error) {
+		lifeTimeSeconds := d.Get("lifetime_seconds").(int)
+		tokenDuration := time.Duration(lifeTimeSeconds) * time.Second
+		return NewTokensAPI(ctx, c).Create(tokenDuration, d.Get("comment").(string))
+	}
 	return common.Resource{
-		Schema: s,
+		Schema:        s,
+		CustomizeDiff: customizeTokenRotationDiff,
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
-			comment := d.Get("comment").(string)
-			lifeTimeSeconds := d.Get("lifetime_seconds").(int)
-			tokenDuration := time.Duration(lifeTimeSeconds) * time.Second
-			tokenResp, err := NewTokensAPI(ctx, c).Create(tokenDuration, comment)
+			tokenResp, err := create(ctx, d, c)
 			if err != nil {
 				return err
 			}
@@ -154,8 +228,20 @@ func ResourceToken() *schema.Resource {
 			}
 			return common.StructToData(tokenInfo, s, d)
 		},
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			tokensAPI := NewTokensAPI(ctx, c)
+			return rotateToken(d, func() (TokenResponse, error) {
+				return create(ctx, d, c)
+			}, tokensAPI.Delete)
+		},
 		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
-			return NewTokensAPI(ctx, c).Delete(d.Id())
+			tokensAPI := NewTokensAPI(ctx, c)
+			if previousID := d.Get("previous_token_id").(string); previousID != "" {
+				if err := tokensAPI.Delete(previousID); err != nil {
+					return err
+				}
+			}
+			return tokensAPI.Delete(d.Id())
 		},
 	}.ToResource()
 }
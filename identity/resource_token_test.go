@@ -199,6 +199,99 @@ func TestResourceTokenCreate_NoExpiration(t *testing.T) {
 	assert.Equal(t, "dapi...", d.Get("token_value"))
 }
 
+func TestTokenRotationDue(t *testing.T) {
+	now := time.Now().UnixNano() / int64(time.Millisecond)
+	day := int64(24 * time.Hour / time.Millisecond)
+	assert.False(t, tokenRotationDue(now, 0))
+	assert.False(t, tokenRotationDue(0, 30))
+	assert.False(t, tokenRotationDue(now-29*day, 30))
+	assert.True(t, tokenRotationDue(now-31*day, 30))
+}
+
+func TestResourceTokenUpdate_Rotates(t *testing.T) {
+	now := time.Now().UnixNano() / int64(time.Millisecond)
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/token/create",
+				ExpectedRequest: TokenRequest{
+					Comment: "ci",
+				},
+				Response: TokenResponse{
+					TokenValue: "dapi-new",
+					TokenInfo: &TokenInfo{
+						TokenID: "bcd",
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/token/list",
+				Response: TokenList{
+					TokenInfos: []TokenInfo{
+						{
+							Comment:      "ci",
+							CreationTime: 10,
+							TokenID:      "abc",
+						},
+						{
+							Comment:      "ci",
+							CreationTime: now,
+							TokenID:      "bcd",
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceToken(),
+		Update:   true,
+		ID:       "abc",
+		InstanceState: map[string]string{
+			"comment":       "ci",
+			"creation_time": "10",
+			"token_id":      "abc",
+			"token_value":   "dapi-old",
+			"rotation_days": "7",
+		},
+		HCL: `comment = "ci"
+		rotation_days = 7`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "bcd", d.Id())
+	assert.Equal(t, "dapi-new", d.Get("token_value"))
+	assert.Equal(t, "abc", d.Get("previous_token_id"))
+	assert.Equal(t, "dapi-old", d.Get("previous_token_value"))
+	assert.Equal(t, int(now), d.Get("creation_time"))
+}
+
+func TestResourceTokenDelete_WithPrevious(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/token/delete",
+				ExpectedRequest: map[string]string{
+					"token_id": "abc",
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/token/delete",
+				ExpectedRequest: map[string]string{
+					"token_id": "bcd",
+				},
+			},
+		},
+		Resource: ResourceToken(),
+		Delete:   true,
+		ID:       "bcd",
+		InstanceState: map[string]string{
+			"previous_token_id": "abc",
+		},
+	}.ApplyNoError(t)
+}
+
 func TestResourceTokenDelete(t *testing.T) {
 	d, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
@@ -1,6 +1,8 @@
 package identity
 
 import (
+	"fmt"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -165,3 +167,80 @@ func scimPatchRequest(op, path, value string) patchRequest {
 		Operations: []patchOperation{o},
 	}
 }
+
+// maxMembersPerPatch limits the number of members changed by a single PATCH request
+const maxMembersPerPatch = 100
+
+// membersPatchRequests batches member additions and removals into PATCH requests,
+// where each of requests changes at most maxMembersPerPatch members
+func membersPatchRequests(add, remove []string) (requests []patchRequest) {
+	for len(add) > 0 {
+		batch := add
+		if len(batch) > maxMembersPerPatch {
+			batch = batch[:maxMembersPerPatch]
+		}
+		add = add[len(batch):]
+		values := make([]ComplexValue, len(batch))
+		for i, v := range batch {
+			values[i] = ComplexValue{Value: v}
+		}
+		requests = append(requests, patchRequest{
+			Schemas: []URN{PatchOp},
+			Operations: []patchOperation{
+				{Op: "add", Path: "members", Value: values},
+			},
+		})
+	}
+	for len(remove) > 0 {
+		batch := remove
+		if len(batch) > maxMembersPerPatch {
+			batch = batch[:maxMembersPerPatch]
+		}
+		remove = remove[len(batch):]
+		operations := make([]patchOperation, len(batch))
+		for i, v := range batch {
+			operations[i] = patchOperation{
+				Op:   "remove",
+				Path: fmt.Sprintf(`members[value eq "%s"]`, v),
+			}
+		}
+		requests = append(requests, patchRequest{
+			Schemas:    []URN{PatchOp},
+			Operations: operations,
+		})
+	}
+	return
+}
+
+// scimPageSize is the number of resources requested from SCIM list APIs at once
+const scimPageSize = 100
+
+// ListRequest is a query to SCIM list APIs with optional filter and attributes projection.
+// Details at https://datatracker.ietf.org/doc/html/rfc7644#section-3.4.2
+type ListRequest struct {
+	Filter     string `url:"filter,omitempty"`
+	Attributes string `url:"attributes,omitempty"`
+	StartIndex int    `url:"startIndex,omitempty"`
+	Count      int    `url:"count,omitempty"`
+}
+
+// listPages calls fetch with consecutive pages of request, until all results are received.
+// fetch returns the number of resources on the page and the total number of results.
+func listPages(req ListRequest, fetch func(ListRequest) (int, int, error)) error {
+	if req.StartIndex == 0 {
+		req.StartIndex = 1
+	}
+	if req.Count == 0 {
+		req.Count = scimPageSize
+	}
+	for {
+		received, total, err := fetch(req)
+		if err != nil {
+			return err
+		}
+		req.StartIndex += received
+		if received == 0 || req.StartIndex > total {
+			return nil
+		}
+	}
+}
@@ -31,19 +31,36 @@ func (a UsersAPI) Create(ru ScimUser) (user ScimUser, err error) {
 	return user, err
 }
 
+// ForEach calls callback for every user matching the request, fetching them page by page
+func (a UsersAPI) ForEach(req ListRequest, callback func(ScimUser) error) error {
+	return listPages(req, func(page ListRequest) (int, int, error) {
+		var users UserList
+		err := a.client.Scim(a.context, http.MethodGet, "/preview/scim/v2/Users", page, &users)
+		if err != nil {
+			return 0, 0, err
+		}
+		for _, u := range users.Resources {
+			if err = callback(u); err != nil {
+				return 0, 0, err
+			}
+		}
+		return len(users.Resources), int(users.TotalResults), nil
+	})
+}
+
+// List returns all users matching the request
+func (a UsersAPI) List(req ListRequest) (users []ScimUser, err error) {
+	users = []ScimUser{}
+	err = a.ForEach(req, func(u ScimUser) error {
+		users = append(users, u)
+		return nil
+	})
+	return
+}
+
 // Filter retrieves users by filter
 func (a UsersAPI) Filter(filter string) (u []ScimUser, err error) {
-	var users UserList
-	req := map[string]string{}
-	if filter != "" {
-		req["filter"] = filter
-	}
-	err = a.client.Scim(a.context, http.MethodGet, "/preview/scim/v2/Users", req, &users)
-	if err != nil {
-		return
-	}
-	u = users.Resources
-	return
+	return a.List(ListRequest{Filter: filter})
 }
 
 func (a UsersAPI) read(userID string) (ScimUser, error) {
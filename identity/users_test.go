@@ -69,7 +69,7 @@ func TestUsersFilter(t *testing.T) {
 	client, server, err := qa.HttpFixtureClient(t, []qa.HTTPFixture{
 		{
 			Method:   "GET",
-			Resource: "/api/2.0/preview/scim/v2/Users?",
+			Resource: "/api/2.0/preview/scim/v2/Users?count=100&startIndex=1",
 
 			Response: UserList{
 				Resources: []ScimUser{
@@ -79,7 +79,7 @@ func TestUsersFilter(t *testing.T) {
 		},
 		{
 			Method:   "GET",
-			Resource: "/api/2.0/preview/scim/v2/Users?filter=userName%20eq%20somebody",
+			Resource: "/api/2.0/preview/scim/v2/Users?count=100&filter=userName+eq+somebody&startIndex=1",
 			Response: UserList{},
 		},
 	})
@@ -95,3 +95,62 @@ func TestUsersFilter(t *testing.T) {
 	require.NoError(t, err)
 	assert.Len(t, users, 0)
 }
+
+func TestUsersForEach_Pages(t *testing.T) {
+	client, server, err := qa.HttpFixtureClient(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/preview/scim/v2/Users?attributes=id&count=2&startIndex=1",
+			Response: UserList{
+				TotalResults: 3,
+				Resources: []ScimUser{
+					{ID: "a"}, {ID: "b"},
+				},
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/preview/scim/v2/Users?attributes=id&count=2&startIndex=3",
+			Response: UserList{
+				TotalResults: 3,
+				Resources: []ScimUser{
+					{ID: "c"},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	defer server.Close()
+	ids := []string{}
+	err = NewUsersAPI(context.Background(), client).ForEach(ListRequest{
+		Attributes: "id",
+		Count:      2,
+	}, func(u ScimUser) error {
+		ids = append(ids, u.ID)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "c"}, ids)
+}
+
+func TestUsersForEach_CallbackError(t *testing.T) {
+	client, server, err := qa.HttpFixtureClient(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/preview/scim/v2/Users?count=100&startIndex=1",
+			Response: UserList{
+				TotalResults: 300,
+				Resources: []ScimUser{
+					{ID: "a"},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	defer server.Close()
+	err = NewUsersAPI(context.Background(), client).ForEach(ListRequest{},
+		func(u ScimUser) error {
+			return fmt.Errorf("stop at %s", u.ID)
+		})
+	assert.EqualError(t, err, "stop at a")
+}
@@ -0,0 +1,102 @@
+package jobs
+
+import (
+	"context"
+	"regexp"
+	"sort"
+
+	"github.com/databrickslabs/terraform-provider-databricks/clusters"
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// JobSummary is the subset of job attributes exposed by listing data source
+type JobSummary struct {
+	JobID             string `json:"job_id,omitempty"`
+	Name              string `json:"name,omitempty"`
+	CreatorUserName   string `json:"creator_user_name,omitempty"`
+	ExistingClusterID string `json:"existing_cluster_id,omitempty"`
+	MaxConcurrentRuns int32  `json:"max_concurrent_runs,omitempty"`
+}
+
+// matchesTags checks custom tags of all job clusters, as jobs don't have own tags
+func (js *JobSettings) matchesTags(filter map[string]string) bool {
+	if len(filter) == 0 {
+		return true
+	}
+	if js.NewCluster != nil && clusters.MatchesTags(js.NewCluster.CustomTags, filter) {
+		return true
+	}
+	for _, task := range js.Tasks {
+		if task.NewCluster != nil && clusters.MatchesTags(task.NewCluster.CustomTags, filter) {
+			return true
+		}
+	}
+	return false
+}
+
+// DataSourceJobs returns jobs matching name regex and custom tags of job clusters
+func DataSourceJobs() *schema.Resource {
+	type jobsFilter struct {
+		NameRegex string            `json:"name_regex,omitempty"`
+		Tags      map[string]string `json:"tags,omitempty"`
+		IDs       []string          `json:"ids,omitempty" tf:"computed,slice_set"`
+		Jobs      []JobSummary      `json:"jobs,omitempty" tf:"computed"`
+	}
+	s := common.StructToSchema(jobsFilter{}, func(
+		s map[string]*schema.Schema) map[string]*schema.Schema {
+		// nolint once SDKv2 has Diagnostics-returning validators, change
+		s["name_regex"].ValidateFunc = validation.StringIsValidRegExp
+		return s
+	})
+	return &schema.Resource{
+		Schema: s,
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			var this jobsFilter
+			err := common.DataToStructPointer(d, s, &this)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			nameRegex, err := regexp.Compile(this.NameRegex)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			list, err := NewJobsAPI(ctx, m).List()
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			sort.Slice(list.Jobs, func(i, j int) bool {
+				return list.Jobs[i].JobID < list.Jobs[j].JobID
+			})
+			this.IDs = []string{}
+			this.Jobs = []JobSummary{}
+			for _, job := range list.Jobs {
+				if job.Settings == nil {
+					continue
+				}
+				if !nameRegex.MatchString(job.Settings.Name) {
+					continue
+				}
+				if !job.Settings.matchesTags(this.Tags) {
+					continue
+				}
+				this.IDs = append(this.IDs, job.ID())
+				this.Jobs = append(this.Jobs, JobSummary{
+					JobID:             job.ID(),
+					Name:              job.Settings.Name,
+					CreatorUserName:   job.CreatorUserName,
+					ExistingClusterID: job.Settings.ExistingClusterID,
+					MaxConcurrentRuns: job.Settings.MaxConcurrentRuns,
+				})
+			}
+			d.SetId("_")
+			err = common.StructToData(this, s, d)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,82 @@
+package jobs
+
+import (
+	"testing"
+
+	"github.com/databrickslabs/terraform-provider-databricks/clusters"
+	"github.com/databrickslabs/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataSourceJobs(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/jobs/list",
+				Response: JobList{
+					Jobs: []Job{
+						{
+							JobID:           2,
+							CreatorUserName: "a@example.com",
+							Settings: &JobSettings{
+								Name: "Nightly ETL",
+								NewCluster: &clusters.Cluster{
+									CustomTags: map[string]string{
+										"team": "data",
+									},
+								},
+							},
+						},
+						{
+							JobID: 1,
+							Settings: &JobSettings{
+								Name: "Nightly ML",
+								Tasks: []JobTaskSettings{
+									{
+										TaskKey: "a",
+										NewCluster: &clusters.Cluster{
+											CustomTags: map[string]string{
+												"team": "data",
+											},
+										},
+									},
+								},
+							},
+						},
+						{
+							JobID: 3,
+							Settings: &JobSettings{
+								Name:              "Nightly report",
+								ExistingClusterID: "abc",
+							},
+						},
+						{
+							JobID: 4,
+						},
+					},
+				},
+			},
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceJobs(),
+		ID:          ".",
+		HCL: `
+		name_regex = "^Nightly"
+		tags = {
+			team = "data"
+		}`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "_", d.Id())
+	assert.Equal(t, 2, d.Get("ids.#"))
+	assert.Equal(t, "1", d.Get("jobs.0.job_id"))
+	assert.Equal(t, "Nightly ML", d.Get("jobs.0.name"))
+	assert.Equal(t, "2", d.Get("jobs.1.job_id"))
+	assert.Equal(t, "a@example.com", d.Get("jobs.1.creator_user_name"))
+}
+
+func TestDataSourceJobs_Error(t *testing.T) {
+	qa.ResourceCornerCases(t, DataSourceJobs(), qa.CornerCaseID("_"))
+}
@@ -391,6 +391,13 @@ func jobSettingsSchema(s *map[string]*schema.Schema, prefix string) {
 		p.ValidateDiagFunc = validation.ToDiagFunc(validation.IntAtLeast(0))
 		p.Required = false
 	}
+	for _, client := range []string{"notebooks", "jobs"} {
+		if p, err := common.SchemaPath(*s, "new_cluster", "workload_type", "clients", client); err == nil {
+			p.Required = false
+			p.Optional = true
+			p.Default = true
+		}
+	}
 	if v, err := common.SchemaPath(*s, "new_cluster", "spark_conf"); err == nil {
 		reSize := common.MustCompileKeyRE(prefix + "new_cluster.0.spark_conf.%")
 		reConf := common.MustCompileKeyRE(prefix + "new_cluster.0.spark_conf.spark.databricks.delta.preview.enabled")
@@ -287,6 +287,23 @@ func TestResourceJobCreate_AlwaysRunning_Conflict(t *testing.T) {
 	}.ExpectError(t, "`always_running` must be specified only with `max_concurrent_runs = 1`")
 }
 
+func TestResourceJobCreate_SingleUserWithoutName(t *testing.T) {
+	qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		new_cluster {
+			num_workers = 1
+			spark_version = "10.1.x-scala2.12"
+			node_type_id = "i3.xlarge"
+			data_security_mode = "SINGLE_USER"
+		}
+		notebook_task {
+			notebook_path = "/Stuff"
+		}`,
+	}.ExpectError(t, "invalid job cluster: data_security_mode SINGLE_USER requires single_user_name")
+}
+
 func TestResourceJobCreateSingleNode(t *testing.T) {
 	cluster := clusters.Cluster{
 		NumWorkers: 0, SparkVersion: "7.3.x-scala2.12", NodeTypeID: "Standard_DS3_v2",
@@ -0,0 +1,53 @@
+package libraries
+
+import (
+	"context"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceLibraryStatuses returns live statuses of all libraries on a cluster
+func DataSourceLibraryStatuses() *schema.Resource {
+	type libraryStatuses struct {
+		ClusterID       string          `json:"cluster_id"`
+		LibraryStatuses []LibraryStatus `json:"library_statuses,omitempty" tf:"computed"`
+		Failed          []string        `json:"failed,omitempty" tf:"computed"`
+	}
+	s := common.StructToSchema(libraryStatuses{}, func(
+		s map[string]*schema.Schema) map[string]*schema.Schema {
+		return s
+	})
+	return &schema.Resource{
+		Schema: s,
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			var this libraryStatuses
+			err := common.DataToStructPointer(d, s, &this)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			cls, err := NewLibrariesAPI(ctx, m).ClusterStatus(this.ClusterID)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			this.LibraryStatuses = []LibraryStatus{}
+			this.Failed = []string{}
+			for _, status := range cls.LibraryStatuses {
+				if status.Library == nil {
+					continue
+				}
+				this.LibraryStatuses = append(this.LibraryStatuses, status)
+				if status.Status == "FAILED" {
+					this.Failed = append(this.Failed, status.Library.String())
+				}
+			}
+			d.SetId(this.ClusterID)
+			err = common.StructToData(this, s, d)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,73 @@
+package libraries
+
+import (
+	"testing"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+	"github.com/databrickslabs/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataSourceLibraryStatuses(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/libraries/cluster-status?cluster_id=abc",
+				Response: ClusterLibraryStatuses{
+					ClusterID: "abc",
+					LibraryStatuses: []LibraryStatus{
+						{
+							Library: &Library{
+								Jar: "dbfs:/FileStore/foo.jar",
+							},
+							Status: "INSTALLED",
+						},
+						{
+							Library: &Library{
+								Pypi: &PyPi{
+									Package: "seaborn==0.0.0",
+								},
+							},
+							Status:   "FAILED",
+							Messages: []string{"No matching distribution found"},
+						},
+					},
+				},
+			},
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceLibraryStatuses(),
+		ID:          ".",
+		HCL:         `cluster_id = "abc"`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "abc", d.Id())
+	assert.Equal(t, 2, d.Get("library_statuses.#"))
+	assert.Equal(t, "dbfs:/FileStore/foo.jar", d.Get("library_statuses.0.library.0.jar"))
+	assert.Equal(t, "FAILED", d.Get("library_statuses.1.status"))
+	assert.Equal(t, "No matching distribution found", d.Get("library_statuses.1.messages.0"))
+	assert.Equal(t, []interface{}{"pypi:seaborn==0.0.0"}, d.Get("failed"))
+}
+
+func TestDataSourceLibraryStatuses_Error(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/libraries/cluster-status?cluster_id=abc",
+				Response: common.APIErrorBody{
+					ErrorCode: "INVALID_STATE",
+					Message:   "Cluster abc does not exist",
+				},
+				Status: 400,
+			},
+		},
+		Resource:    DataSourceLibraryStatuses(),
+		Read:        true,
+		NonWritable: true,
+		ID:          ".",
+		HCL:         `cluster_id = "abc"`,
+	}.ExpectError(t, "Cluster abc does not exist")
+}
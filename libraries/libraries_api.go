@@ -2,7 +2,9 @@ package libraries
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log"
 	"sort"
 	"strings"
 	"time"
@@ -143,6 +145,10 @@ func NewLibraryFromInstanceState(i interface{}) (lib Library) {
 		maven := mavenList[0].(map[string]interface{})
 		lib.Maven.Coordinates, _ = maven["coordinates"].(string)
 		lib.Maven.Repo, _ = maven["repo"].(string)
+		exclusions, _ := maven["exclusions"].([]interface{})
+		for _, e := range exclusions {
+			lib.Maven.Exclusions = append(lib.Maven.Exclusions, e.(string))
+		}
 	}
 	cranList, ok := raw["cran"].([]interface{})
 	if ok && len(cranList) == 1 {
@@ -198,10 +204,17 @@ type ClusterLibraryList struct {
 func (cll *ClusterLibraryList) Diff(cls ClusterLibraryStatuses) (ClusterLibraryList, ClusterLibraryList) {
 	inConfig := map[string]Library{}
 	for _, lib := range cll.Libraries {
+		if lib.String() == "unknown" {
+			// removed elements of `library` set may come back empty
+			continue
+		}
 		inConfig[lib.String()] = lib
 	}
 	inState := map[string]Library{}
 	for _, status := range cls.LibraryStatuses {
+		if status.Library == nil {
+			continue
+		}
 		lib := *status.Library
 		inState[lib.String()] = lib
 	}
@@ -232,6 +245,17 @@ func (cll *ClusterLibraryList) Sort() {
 	})
 }
 
+// Only returns copy of the list with libraries, which keys are present in given set
+func (cll ClusterLibraryList) Only(keys map[string]bool) ClusterLibraryList {
+	result := ClusterLibraryList{ClusterID: cll.ClusterID}
+	for _, lib := range cll.Libraries {
+		if keys[lib.String()] {
+			result.Libraries = append(result.Libraries, lib)
+		}
+	}
+	return result
+}
+
 func (cll *ClusterLibraryList) String() string {
 	libs := make([]string, len(cll.Libraries))
 	for i, lib := range cll.Libraries {
@@ -261,19 +285,88 @@ type ClusterLibraryStatuses struct {
 func (cls ClusterLibraryStatuses) ToLibraryList() ClusterLibraryList {
 	cll := ClusterLibraryList{ClusterID: cls.ClusterID}
 	for _, lib := range cls.LibraryStatuses {
+		if lib.Library == nil {
+			continue
+		}
 		cll.Libraries = append(cll.Libraries, *lib.Library)
 	}
 	cll.Sort()
 	return cll
 }
 
+// Active returns statuses of libraries, that neither failed to install nor are marked for removal
+func (cls ClusterLibraryStatuses) Active() ClusterLibraryStatuses {
+	result := ClusterLibraryStatuses{ClusterID: cls.ClusterID}
+	for _, status := range cls.LibraryStatuses {
+		if status.Status == "FAILED" || status.Status == "UNINSTALL_ON_RESTART" {
+			continue
+		}
+		result.LibraryStatuses = append(result.LibraryStatuses, status)
+	}
+	return result
+}
+
+// LibraryInstallationError reports libraries, that failed to install on a cluster
+type LibraryInstallationError struct {
+	ClusterID string
+	Failed    []LibraryStatus
+}
+
+func (e LibraryInstallationError) Error() string {
+	failures := make([]string, len(e.Failed))
+	for i, status := range e.Failed {
+		messages := "no details"
+		if len(status.Messages) > 0 {
+			messages = strings.Join(status.Messages, ", ")
+		}
+		failures[i] = fmt.Sprintf("%s failed: %s", status.Library, messages)
+	}
+	return strings.Join(failures, "\n")
+}
+
+// ToLibraryList returns failed libraries, so that they could be uninstalled
+func (e LibraryInstallationError) ToLibraryList() ClusterLibraryList {
+	cll := ClusterLibraryList{ClusterID: e.ClusterID}
+	for _, status := range e.Failed {
+		if status.Library == nil {
+			continue
+		}
+		cll.Libraries = append(cll.Libraries, *status.Library)
+	}
+	return cll
+}
+
+// UninstallFailed uninstalls libraries with given keys, that failed to install, and returns their
+// statuses instead of failing. Failures of other libraries are left to their owners.
+// Errors other than LibraryInstallationError are returned as is.
+func (a LibrariesAPI) UninstallFailed(err error, managed map[string]bool) ([]LibraryStatus, error) {
+	var installErr LibraryInstallationError
+	if !errors.As(err, &installErr) {
+		return nil, err
+	}
+	uninstall := LibraryInstallationError{ClusterID: installErr.ClusterID}
+	for _, status := range installErr.Failed {
+		if status.Library == nil || !managed[status.Library.String()] {
+			log.Printf("[DEBUG] %s failed to install on cluster %s, but it's not managed here",
+				status.Library, installErr.ClusterID)
+			continue
+		}
+		uninstall.Failed = append(uninstall.Failed, status)
+	}
+	if len(uninstall.Failed) == 0 {
+		return nil, nil
+	}
+	log.Printf("[WARN] Uninstalling failed libraries from cluster %s: %s", installErr.ClusterID, uninstall)
+	return uninstall.Failed, a.Uninstall(uninstall.ToLibraryList())
+}
+
 // IsRetryNeeded returns first bool if there needs to be retry.
 // If there needs to be retry, error message will explain why.
 // If retry does not need to happen and error is not nil - it failed.
 func (cls ClusterLibraryStatuses) IsRetryNeeded() (bool, error) {
 	pending := 0
 	ready := 0
-	errors := []string{}
+	failed := []LibraryStatus{}
 	for _, lib := range cls.LibraryStatuses {
 		if lib.IsLibraryInstalledOnAllClusters {
 			continue
@@ -300,15 +393,18 @@ func (cls ClusterLibraryStatuses) IsRetryNeeded() (bool, error) {
 			ready++
 		//Some step in installation failed. More information can be found in the messages field.
 		case "FAILED":
-			errors = append(errors, fmt.Sprintf("%s failed: %s", lib.Library, strings.Join(lib.Messages, ", ")))
+			failed = append(failed, lib)
 			continue
 		}
 	}
 	if pending > 0 {
 		return true, fmt.Errorf("%d libraries are ready, but there are still %d pending", ready, pending)
 	}
-	if len(errors) > 0 {
-		return false, fmt.Errorf("%s", strings.Join(errors, "\n"))
+	if len(failed) > 0 {
+		return false, LibraryInstallationError{
+			ClusterID: cls.ClusterID,
+			Failed:    failed,
+		}
 	}
 	return false, nil
 }
@@ -2,6 +2,8 @@ package libraries
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"testing"
 	"time"
 
@@ -75,6 +77,9 @@ func TestWaitForLibrariesInstalled(t *testing.T) {
 
 		_, err = libs.WaitForLibrariesInstalled("failed-wheel", 50*time.Millisecond)
 		assert.EqualError(t, err, "whl:b.whl failed: does not compute")
+		var installErr LibraryInstallationError
+		require.True(t, errors.As(err, &installErr))
+		assert.Len(t, installErr.Failed, 1)
 
 	})
 }
@@ -299,6 +304,9 @@ func TestNewLibraryFromInstanceState(t *testing.T) {
 		{"mvn:e", map[string]interface{}{"maven": []interface{}{
 			map[string]interface{}{"coordinates": "e"},
 		}}},
+		{"mvn:ex", map[string]interface{}{"maven": []interface{}{
+			map[string]interface{}{"coordinates": "e", "exclusions": []interface{}{"x"}},
+		}}},
 		{"cran:f", map[string]interface{}{"cran": []interface{}{
 			map[string]interface{}{"package": "f"},
 		}}},
@@ -312,3 +320,119 @@ func TestNewLibraryFromInstanceState(t *testing.T) {
 		})
 	}
 }
+
+func TestClusterLibraryList_Only(t *testing.T) {
+	cll := ClusterLibraryList{
+		ClusterID: "abc",
+		Libraries: []Library{
+			{Jar: "a"},
+			{Whl: "b"},
+			{Pypi: &PyPi{Package: "c"}},
+		},
+	}
+	only := cll.Only(map[string]bool{
+		"whl:b":  true,
+		"pypi:c": true,
+		"egg:d":  true,
+	})
+	assert.Equal(t, "abc/whl:b,pypi:c", only.String())
+}
+
+func TestLibraryInstallationError(t *testing.T) {
+	err := LibraryInstallationError{
+		ClusterID: "abc",
+		Failed: []LibraryStatus{
+			{
+				Library:  &Library{Whl: "a.whl"},
+				Messages: []string{"bad wheel", "really"},
+			},
+			{
+				Library: &Library{Jar: "b.jar"},
+			},
+		},
+	}
+	assert.EqualError(t, err, "whl:a.whl failed: bad wheel, really\njar:b.jar failed: no details")
+	failed := err.ToLibraryList()
+	assert.Equal(t, "abc/whl:a.whl,jar:b.jar", failed.String())
+}
+
+func TestUninstallFailed(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "POST",
+			Resource: "/api/2.0/libraries/uninstall",
+			ExpectedRequest: ClusterLibraryList{
+				ClusterID: "abc",
+				Libraries: []Library{
+					{
+						Whl: "a.whl",
+					},
+				},
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		libs := NewLibrariesAPI(ctx, client)
+		_, err := libs.UninstallFailed(fmt.Errorf("nope"), map[string]bool{})
+		assert.EqualError(t, err, "nope")
+		uninstalled, err := libs.UninstallFailed(LibraryInstallationError{
+			ClusterID: "abc",
+			Failed: []LibraryStatus{
+				{
+					Library: &Library{Whl: "a.whl"},
+					Status:  "FAILED",
+				},
+				{
+					// installed by databricks_library
+					Library: &Library{Jar: "b.jar"},
+					Status:  "FAILED",
+				},
+				{
+					Status: "FAILED",
+				},
+			},
+		}, map[string]bool{"whl:a.whl": true})
+		require.NoError(t, err)
+		require.Len(t, uninstalled, 1)
+		assert.Equal(t, "whl:a.whl", uninstalled[0].Library.String())
+	})
+}
+
+func TestUninstallFailed_NothingManaged(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{}, func(ctx context.Context, client *common.DatabricksClient) {
+		uninstalled, err := NewLibrariesAPI(ctx, client).UninstallFailed(LibraryInstallationError{
+			ClusterID: "abc",
+			Failed: []LibraryStatus{
+				{
+					Library: &Library{Jar: "b.jar"},
+					Status:  "FAILED",
+				},
+			},
+		}, map[string]bool{"whl:a.whl": true})
+		require.NoError(t, err)
+		assert.Len(t, uninstalled, 0)
+	})
+}
+
+func TestClusterLibraryStatuses_Active(t *testing.T) {
+	cll := ClusterLibraryStatuses{
+		ClusterID: "abc",
+		LibraryStatuses: []LibraryStatus{
+			{
+				Library: &Library{Jar: "a"},
+				Status:  "INSTALLED",
+			},
+			{
+				Library: &Library{Whl: "b"},
+				Status:  "FAILED",
+			},
+			{
+				Library: &Library{Egg: "c"},
+				Status:  "UNINSTALL_ON_RESTART",
+			},
+			{
+				Status: "INSTALLED",
+			},
+		},
+	}.Active().ToLibraryList()
+	assert.Equal(t, "abc/jar:a", cll.String())
+}
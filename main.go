@@ -5,6 +5,7 @@ import (
 	"log"
 	"os"
 
+	"github.com/databrickslabs/terraform-provider-databricks/commands"
 	"github.com/databrickslabs/terraform-provider-databricks/common"
 	"github.com/databrickslabs/terraform-provider-databricks/exporter"
 	"github.com/databrickslabs/terraform-provider-databricks/provider"
@@ -18,7 +19,9 @@ func main() {
 		return
 	}
 	if len(os.Args) > 1 && os.Args[1] == "exporter" {
-		if err := exporter.Run(os.Args...); err != nil {
+		err := exporter.Run(os.Args...)
+		commands.CloseContextPools()
+		if err != nil {
 			log.Printf("[ERROR] %s", err.Error())
 			os.Exit(1)
 		}
@@ -32,4 +35,8 @@ https://registry.terraform.io/providers/databrickslabs/databricks/latest/docs
 
 `, common.Version())
 	plugin.Serve(&plugin.ServeOpts{ProviderFunc: provider.DatabricksProvider})
+	// execution contexts are kept between resource operations and have to be destroyed,
+	// once Terraform shuts down the provider. This is best effort, as the process is
+	// killed shortly after, so idle contexts are also evicted in background every minute
+	commands.CloseContextPools()
 }
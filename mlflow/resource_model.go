@@ -2,6 +2,8 @@ package mlflow
 
 import (
 	"context"
+	"encoding/json"
+	"log"
 
 	"github.com/databrickslabs/terraform-provider-databricks/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -9,8 +11,61 @@ import (
 
 // Tag ...
 type Tag struct {
-	Key   string `json:"key" tf:"force_new"`
-	Value string `json:"value" tf:"force_new"`
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// tagChanges returns tags to set and keys of tags to delete in order to get from old to new tags
+func tagChanges(old, new []Tag) (set []Tag, deleted []string) {
+	oldValues := map[string]string{}
+	for _, t := range old {
+		oldValues[t.Key] = t.Value
+	}
+	newKeys := map[string]bool{}
+	for _, t := range new {
+		newKeys[t.Key] = true
+		if v, ok := oldValues[t.Key]; ok && v == t.Value {
+			continue
+		}
+		set = append(set, t)
+	}
+	for _, t := range old {
+		if !newKeys[t.Key] {
+			deleted = append(deleted, t.Key)
+		}
+	}
+	return
+}
+
+// tagsToData converts tags to the format of the state
+func tagsToData(tags []Tag) []interface{} {
+	result := []interface{}{}
+	for _, t := range tags {
+		result = append(result, map[string]interface{}{
+			"key":   t.Key,
+			"value": t.Value,
+		})
+	}
+	return result
+}
+
+// tagsChange returns old and new tags from the state
+func tagsChange(d *schema.ResourceData) (old, new []Tag) {
+	toTags := func(raw interface{}) (tags []Tag) {
+		for _, v := range raw.([]interface{}) {
+			m, ok := v.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			tags = append(tags, Tag{
+				Key:   m["key"].(string),
+				Value: m["value"].(string),
+			})
+		}
+		return
+	}
+	o, n := d.GetChange("tags")
+	return toTags(o), toTags(n)
 }
 
 // Model defines the response object from the API
@@ -21,7 +76,24 @@ type Model struct {
 	UserID               string   `json:"user_id,omitempty" tf:"computed"`
 	LatestVersions       []string `json:"latest_versions,omitempty" tf:"computed"`
 	Description          string   `json:"description,omitempty"`
-	Tags                 []Tag    `json:"tags,omitempty" tf:"force_new"`
+	Tags                 []Tag    `json:"tags,omitempty"`
+}
+
+// UnmarshalJSON keeps only version numbers from `latest_versions`, as API returns complete model versions
+func (m *Model) UnmarshalJSON(b []byte) error {
+	type model Model
+	aux := struct {
+		*model
+		LatestVersions []ModelVersion `json:"latest_versions,omitempty"`
+	}{model: (*model)(m)}
+	if err := json.Unmarshal(b, &aux); err != nil {
+		return err
+	}
+	m.LatestVersions = nil
+	for _, v := range aux.LatestVersions {
+		m.LatestVersions = append(m.LatestVersions, v.Version)
+	}
+	return nil
 }
 
 // registeredModel defines response from GET API op
@@ -60,7 +132,44 @@ func (a ModelsAPI) Read(name string) (*Model, error) {
 
 // Update ...
 func (a ModelsAPI) Update(m *Model) error {
-	return a.client.Patch(a.context, "/mlflow/registered-models/update", m)
+	return a.client.Patch(a.context, "/mlflow/registered-models/update", Model{
+		Name:        m.Name,
+		Description: m.Description,
+	})
+}
+
+// SetTag ...
+func (a ModelsAPI) SetTag(name string, tag Tag) error {
+	return a.client.Post(a.context, "/mlflow/registered-models/set-tag", map[string]string{
+		"name":  name,
+		"key":   tag.Key,
+		"value": tag.Value,
+	}, nil)
+}
+
+// DeleteTag ...
+func (a ModelsAPI) DeleteTag(name, key string) error {
+	return a.client.Delete(a.context, "/mlflow/registered-models/delete-tag", map[string]string{
+		"name": name,
+		"key":  key,
+	})
+}
+
+// UpdateTags sets changed tags and deletes removed ones
+func (a ModelsAPI) UpdateTags(name string, old, new []Tag) error {
+	set, deleted := tagChanges(old, new)
+	for _, key := range deleted {
+		log.Printf("[DEBUG] Deleting tag %s from model %s", key, name)
+		if err := a.DeleteTag(name, key); err != nil {
+			return err
+		}
+	}
+	for _, tag := range set {
+		if err := a.SetTag(name, tag); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // Delete ...
@@ -92,14 +201,28 @@ func ResourceMLFlowModel() *schema.Resource {
 			if err != nil {
 				return err
 			}
-			return common.StructToData(*m, s, d)
+			if err = common.StructToData(*m, s, d); err != nil {
+				return err
+			}
+			// tags could be removed outside of Terraform
+			return d.Set("tags", tagsToData(m.Tags))
 		},
 		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			var m Model
 			if err := common.DataToStructPointer(d, s, &m); err != nil {
 				return nil
 			}
-			return NewModelsAPI(ctx, c).Update(&m)
+			modelsAPI := NewModelsAPI(ctx, c)
+			if d.HasChange("description") {
+				if err := modelsAPI.Update(&m); err != nil {
+					return err
+				}
+			}
+			if !d.HasChange("tags") {
+				return nil
+			}
+			old, new := tagsChange(d)
+			return modelsAPI.UpdateTags(m.Name, old, new)
 		},
 		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			var m Model
@@ -237,3 +237,87 @@ func TestModelDeleteError(t *testing.T) {
 
 	assert.Error(t, err, err)
 }
+
+func TestModelUpdateTagsInPlace(t *testing.T) {
+	gm := m()
+	gm.Tags = []Tag{
+		{Key: "key1", Value: "changed"},
+	}
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "DELETE",
+				Resource: "/api/2.0/mlflow/registered-models/delete-tag",
+				ExpectedRequest: map[string]string{
+					"name": "xyz",
+					"key":  "key2",
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/mlflow/registered-models/set-tag",
+				ExpectedRequest: map[string]string{
+					"name":  "xyz",
+					"key":   "key1",
+					"value": "changed",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/mlflow/registered-models/get?name=xyz",
+				Response: registeredModel{
+					RegisteredModel: gm,
+				},
+			},
+		},
+		Resource: ResourceMLFlowModel(),
+		Update:   true,
+		ID:       "xyz",
+		InstanceState: map[string]string{
+			"name":         "xyz",
+			"tags.#":       "2",
+			"tags.0.key":   "key1",
+			"tags.0.value": "value1",
+			"tags.1.key":   "key2",
+			"tags.1.value": "value2",
+		},
+		HCL: `
+		name = "xyz"
+		tags {
+			key = "key1"
+			value = "changed"
+		}
+		`,
+	}.Apply(t)
+
+	assert.NoError(t, err, err)
+	assert.Equal(t, "xyz", d.Id(), "Model should not be recreated")
+	assert.Equal(t, "changed", d.Get("tags.0.value"))
+}
+
+func TestModelReadLatestVersions(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/mlflow/registered-models/get?name=xyz",
+				Response: `{
+					"registered_model": {
+						"name": "xyz",
+						"latest_versions": [
+							{"name": "xyz", "version": "1", "current_stage": "Production"},
+							{"name": "xyz", "version": "2", "current_stage": "None"}
+						]
+					}
+				}`,
+			},
+		},
+		Resource: ResourceMLFlowModel(),
+		Read:     true,
+		New:      true,
+		ID:       "xyz",
+	}.Apply(t)
+
+	assert.NoError(t, err, err)
+	assert.Equal(t, "2", d.Get("latest_versions.1"))
+}
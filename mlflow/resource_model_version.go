@@ -0,0 +1,282 @@
+package mlflow
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Stages of model versions
+var Stages = []string{"None", "Staging", "Production", "Archived"}
+
+// DefaultRegistrationTimeout is the amount of time model version is waited for to become ready
+const DefaultRegistrationTimeout = 10 * time.Minute
+
+// ModelVersion defines the response object from the API
+type ModelVersion struct {
+	Name                 string `json:"name" tf:"force_new"`
+	Version              string `json:"version,omitempty" tf:"computed"`
+	Source               string `json:"source" tf:"force_new"`
+	RunID                string `json:"run_id,omitempty" tf:"force_new"`
+	RunLink              string `json:"run_link,omitempty" tf:"force_new"`
+	Description          string `json:"description,omitempty"`
+	Tags                 []Tag  `json:"tags,omitempty"`
+	CurrentStage         string `json:"current_stage,omitempty" tf:"alias:stage"`
+	Status               string `json:"status,omitempty" tf:"computed"`
+	StatusMessage        string `json:"status_message,omitempty" tf:"computed"`
+	UserID               string `json:"user_id,omitempty" tf:"computed"`
+	CreationTimestamp    int64  `json:"creation_timestamp,omitempty" tf:"computed"`
+	LastUpdatedTimestamp int64  `json:"last_updated_timestamp,omitempty" tf:"computed"`
+}
+
+type modelVersionWrapper struct {
+	ModelVersion ModelVersion `json:"model_version"`
+}
+
+type modelVersionID struct {
+	Name    string `json:"name" url:"name"`
+	Version string `json:"version" url:"version"`
+}
+
+type modelVersionDescription struct {
+	Name        string `json:"name"`
+	Version     string `json:"version"`
+	Description string `json:"description"`
+}
+
+type modelVersionTag struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	Key     string `json:"key"`
+	Value   string `json:"value,omitempty"`
+}
+
+type modelVersionStageTransition struct {
+	Name                    string `json:"name"`
+	Version                 string `json:"version"`
+	Stage                   string `json:"stage"`
+	ArchiveExistingVersions bool   `json:"archive_existing_versions"`
+}
+
+// ModelVersionsAPI ...
+type ModelVersionsAPI struct {
+	client  *common.DatabricksClient
+	context context.Context
+}
+
+// NewModelVersionsAPI ...
+func NewModelVersionsAPI(ctx context.Context, m interface{}) ModelVersionsAPI {
+	return ModelVersionsAPI{m.(*common.DatabricksClient), ctx}
+}
+
+// Create registers new version of a model
+func (a ModelVersionsAPI) Create(mv *ModelVersion) error {
+	var w modelVersionWrapper
+	err := a.client.Post(a.context, "/mlflow/model-versions/create", ModelVersion{
+		Name:        mv.Name,
+		Source:      mv.Source,
+		RunID:       mv.RunID,
+		RunLink:     mv.RunLink,
+		Description: mv.Description,
+		Tags:        mv.Tags,
+	}, &w)
+	if err != nil {
+		return err
+	}
+	mv.Version = w.ModelVersion.Version
+	return nil
+}
+
+// Read ...
+func (a ModelVersionsAPI) Read(name, version string) (mv ModelVersion, err error) {
+	var w modelVersionWrapper
+	err = a.client.Get(a.context, "/mlflow/model-versions/get", modelVersionID{
+		Name:    name,
+		Version: version,
+	}, &w)
+	mv = w.ModelVersion
+	return
+}
+
+// UpdateDescription ...
+func (a ModelVersionsAPI) UpdateDescription(name, version, description string) error {
+	return a.client.Patch(a.context, "/mlflow/model-versions/update", modelVersionDescription{
+		Name:        name,
+		Version:     version,
+		Description: description,
+	})
+}
+
+// UpdateTags sets changed tags and deletes removed ones
+func (a ModelVersionsAPI) UpdateTags(name, version string, old, new []Tag) error {
+	set, deleted := tagChanges(old, new)
+	for _, key := range deleted {
+		log.Printf("[DEBUG] Deleting tag %s from model %s version %s", key, name, version)
+		err := a.client.Delete(a.context, "/mlflow/model-versions/delete-tag", modelVersionTag{
+			Name:    name,
+			Version: version,
+			Key:     key,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	for _, tag := range set {
+		err := a.client.Post(a.context, "/mlflow/model-versions/set-tag", modelVersionTag{
+			Name:    name,
+			Version: version,
+			Key:     tag.Key,
+			Value:   tag.Value,
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// TransitionStage moves model version to another stage, optionally archiving
+// versions, that are currently in that stage
+func (a ModelVersionsAPI) TransitionStage(name, version, stage string, archiveExisting bool) error {
+	return a.client.Post(a.context, "/mlflow/model-versions/transition-stage", modelVersionStageTransition{
+		Name:                    name,
+		Version:                 version,
+		Stage:                   stage,
+		ArchiveExistingVersions: archiveExisting,
+	}, nil)
+}
+
+// Delete ...
+func (a ModelVersionsAPI) Delete(name, version string) error {
+	return a.client.Delete(a.context, "/mlflow/model-versions/delete", modelVersionID{
+		Name:    name,
+		Version: version,
+	})
+}
+
+// waitForReady waits until model version registration is finished
+func (a ModelVersionsAPI) waitForReady(name, version string, timeout time.Duration) error {
+	return resource.RetryContext(a.context, timeout, func() *resource.RetryError {
+		mv, err := a.Read(name, version)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		switch mv.Status {
+		case "READY":
+			return nil
+		case "FAILED_REGISTRATION":
+			return resource.NonRetryableError(fmt.Errorf(
+				"failed to register version %s of %s: %s", version, name, mv.StatusMessage))
+		default:
+			msg := fmt.Errorf("version %s of %s is %s", version, name, mv.Status)
+			log.Printf("[INFO] %s", msg.Error())
+			return resource.RetryableError(msg)
+		}
+	})
+}
+
+func parseModelVersionID(id string) (name, version string, err error) {
+	i := strings.LastIndex(id, "/")
+	if i <= 0 || i == len(id)-1 {
+		err = fmt.Errorf("invalid model version ID: %s", id)
+		return
+	}
+	return id[:i], id[i+1:], nil
+}
+
+// ResourceMLFlowModelVersion ...
+func ResourceMLFlowModelVersion() *schema.Resource {
+	s := common.StructToSchema(
+		ModelVersion{},
+		func(m map[string]*schema.Schema) map[string]*schema.Schema {
+			m["stage"].Default = "None"
+			m["stage"].ValidateFunc = validation.StringInSlice(Stages, false)
+			m["archive_existing_versions"] = &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			}
+			return m
+		})
+
+	return common.Resource{
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var mv ModelVersion
+			if err := common.DataToStructPointer(d, s, &mv); err != nil {
+				return err
+			}
+			versionsAPI := NewModelVersionsAPI(ctx, c)
+			if err := versionsAPI.Create(&mv); err != nil {
+				return err
+			}
+			d.SetId(fmt.Sprintf("%s/%s", mv.Name, mv.Version))
+			err := versionsAPI.waitForReady(mv.Name, mv.Version, d.Timeout(schema.TimeoutCreate))
+			if err != nil {
+				return err
+			}
+			if mv.CurrentStage == "None" {
+				return nil
+			}
+			return versionsAPI.TransitionStage(mv.Name, mv.Version, mv.CurrentStage,
+				d.Get("archive_existing_versions").(bool))
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			name, version, err := parseModelVersionID(d.Id())
+			if err != nil {
+				return err
+			}
+			mv, err := NewModelVersionsAPI(ctx, c).Read(name, version)
+			if err != nil {
+				return err
+			}
+			if err = common.StructToData(mv, s, d); err != nil {
+				return err
+			}
+			// tags and description could be removed outside of Terraform
+			d.Set("description", mv.Description)
+			return d.Set("tags", tagsToData(mv.Tags))
+		},
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var mv ModelVersion
+			if err := common.DataToStructPointer(d, s, &mv); err != nil {
+				return err
+			}
+			versionsAPI := NewModelVersionsAPI(ctx, c)
+			if d.HasChange("description") {
+				err := versionsAPI.UpdateDescription(mv.Name, mv.Version, mv.Description)
+				if err != nil {
+					return err
+				}
+			}
+			if d.HasChange("tags") {
+				old, new := tagsChange(d)
+				if err := versionsAPI.UpdateTags(mv.Name, mv.Version, old, new); err != nil {
+					return err
+				}
+			}
+			if !d.HasChange("stage") {
+				return nil
+			}
+			return versionsAPI.TransitionStage(mv.Name, mv.Version, mv.CurrentStage,
+				d.Get("archive_existing_versions").(bool))
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			name, version, err := parseModelVersionID(d.Id())
+			if err != nil {
+				return err
+			}
+			return NewModelVersionsAPI(ctx, c).Delete(name, version)
+		},
+		Schema: s,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultRegistrationTimeout),
+		},
+	}.ToResource()
+}
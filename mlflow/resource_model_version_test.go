@@ -0,0 +1,263 @@
+package mlflow
+
+import (
+	"testing"
+
+	"github.com/databrickslabs/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+)
+
+func mv(stage, status string) modelVersionWrapper {
+	return modelVersionWrapper{
+		ModelVersion: ModelVersion{
+			Name:         "xyz",
+			Version:      "3",
+			Source:       "dbfs:/databricks/mlflow/1/abc/artifacts/model",
+			RunID:        "abc",
+			CurrentStage: stage,
+			Status:       status,
+			Tags: []Tag{
+				{Key: "key1", Value: "value1"},
+			},
+		},
+	}
+}
+
+func TestModelVersionCreate(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/mlflow/model-versions/create",
+				ExpectedRequest: ModelVersion{
+					Name:   "xyz",
+					Source: "dbfs:/databricks/mlflow/1/abc/artifacts/model",
+					RunID:  "abc",
+					Tags: []Tag{
+						{Key: "key1", Value: "value1"},
+					},
+				},
+				Response: mv("None", "PENDING_REGISTRATION"),
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/mlflow/model-versions/get?name=xyz&version=3",
+				Response: mv("None", "PENDING_REGISTRATION"),
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/mlflow/model-versions/get?name=xyz&version=3",
+				Response: mv("None", "READY"),
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/mlflow/model-versions/transition-stage",
+				ExpectedRequest: modelVersionStageTransition{
+					Name:                    "xyz",
+					Version:                 "3",
+					Stage:                   "Production",
+					ArchiveExistingVersions: true,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/mlflow/model-versions/get?name=xyz&version=3",
+				Response: mv("Production", "READY"),
+			},
+		},
+		Resource: ResourceMLFlowModelVersion(),
+		Create:   true,
+		HCL: `
+		name = "xyz"
+		source = "dbfs:/databricks/mlflow/1/abc/artifacts/model"
+		run_id = "abc"
+		stage = "Production"
+		archive_existing_versions = true
+		tags {
+			key = "key1"
+			value = "value1"
+		}
+		`,
+	}.Apply(t)
+
+	assert.NoError(t, err, err)
+	assert.Equal(t, "xyz/3", d.Id())
+	assert.Equal(t, "3", d.Get("version"))
+	assert.Equal(t, "Production", d.Get("stage"))
+	assert.Equal(t, "READY", d.Get("status"))
+}
+
+func TestModelVersionCreate_FailedRegistration(t *testing.T) {
+	failed := mv("None", "FAILED_REGISTRATION")
+	failed.ModelVersion.StatusMessage = "source not found"
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/mlflow/model-versions/create",
+				Response: mv("None", "PENDING_REGISTRATION"),
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/mlflow/model-versions/get?name=xyz&version=3",
+				Response: failed,
+			},
+		},
+		Resource: ResourceMLFlowModelVersion(),
+		Create:   true,
+		HCL: `
+		name = "xyz"
+		source = "dbfs:/databricks/mlflow/1/abc/artifacts/model"
+		`,
+	}.ExpectError(t, "failed to register version 3 of xyz: source not found")
+}
+
+func TestModelVersionRead(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/mlflow/model-versions/get?name=xyz&version=3",
+				Response: mv("Staging", "READY"),
+			},
+		},
+		Resource: ResourceMLFlowModelVersion(),
+		Read:     true,
+		New:      true,
+		ID:       "xyz/3",
+	}.Apply(t)
+
+	assert.NoError(t, err, err)
+	assert.Equal(t, "xyz", d.Get("name"))
+	assert.Equal(t, "Staging", d.Get("stage"))
+	assert.Equal(t, "value1", d.Get("tags.0.value"))
+}
+
+func TestModelVersionRead_InvalidID(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceMLFlowModelVersion(),
+		Read:     true,
+		New:      true,
+		ID:       "xyz",
+	}.ExpectError(t, "invalid model version ID: xyz")
+}
+
+func TestModelVersionUpdate(t *testing.T) {
+	updated := mv("Archived", "READY")
+	updated.ModelVersion.Description = "old model"
+	updated.ModelVersion.Tags = []Tag{
+		{Key: "key2", Value: "value2"},
+	}
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.0/mlflow/model-versions/update",
+				ExpectedRequest: modelVersionDescription{
+					Name:        "xyz",
+					Version:     "3",
+					Description: "old model",
+				},
+			},
+			{
+				Method:   "DELETE",
+				Resource: "/api/2.0/mlflow/model-versions/delete-tag",
+				ExpectedRequest: modelVersionTag{
+					Name:    "xyz",
+					Version: "3",
+					Key:     "key1",
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/mlflow/model-versions/set-tag",
+				ExpectedRequest: modelVersionTag{
+					Name:    "xyz",
+					Version: "3",
+					Key:     "key2",
+					Value:   "value2",
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/mlflow/model-versions/transition-stage",
+				ExpectedRequest: modelVersionStageTransition{
+					Name:    "xyz",
+					Version: "3",
+					Stage:   "Archived",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/mlflow/model-versions/get?name=xyz&version=3",
+				Response: updated,
+			},
+		},
+		Resource: ResourceMLFlowModelVersion(),
+		Update:   true,
+		ID:       "xyz/3",
+		InstanceState: map[string]string{
+			"name":         "xyz",
+			"version":      "3",
+			"source":       "dbfs:/databricks/mlflow/1/abc/artifacts/model",
+			"run_id":       "abc",
+			"stage":        "Production",
+			"tags.#":       "1",
+			"tags.0.key":   "key1",
+			"tags.0.value": "value1",
+		},
+		HCL: `
+		name = "xyz"
+		source = "dbfs:/databricks/mlflow/1/abc/artifacts/model"
+		run_id = "abc"
+		description = "old model"
+		stage = "Archived"
+		tags {
+			key = "key2"
+			value = "value2"
+		}
+		`,
+	}.Apply(t)
+
+	assert.NoError(t, err, err)
+	assert.Equal(t, "Archived", d.Get("stage"))
+	assert.Equal(t, "key2", d.Get("tags.0.key"))
+}
+
+func TestModelVersionDelete(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "DELETE",
+				Resource: "/api/2.0/mlflow/model-versions/delete",
+				ExpectedRequest: modelVersionID{
+					Name:    "xyz",
+					Version: "3",
+				},
+			},
+		},
+		Resource: ResourceMLFlowModelVersion(),
+		Delete:   true,
+		ID:       "xyz/3",
+	}.Apply(t)
+
+	assert.NoError(t, err, err)
+	assert.Equal(t, "xyz/3", d.Id())
+}
+
+func TestTagChanges(t *testing.T) {
+	set, deleted := tagChanges([]Tag{
+		{Key: "same", Value: "a"},
+		{Key: "changed", Value: "b"},
+		{Key: "removed", Value: "c"},
+	}, []Tag{
+		{Key: "same", Value: "a"},
+		{Key: "changed", Value: "d"},
+		{Key: "added", Value: "e"},
+	})
+	assert.Equal(t, []Tag{
+		{Key: "changed", Value: "d"},
+		{Key: "added", Value: "e"},
+	}, set)
+	assert.Equal(t, []string{"removed"}, deleted)
+}
@@ -0,0 +1,149 @@
+package pipelines
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+type eventOrigin struct {
+	UpdateID string `json:"update_id,omitempty"`
+	FlowName string `json:"flow_name,omitempty"`
+}
+
+type eventError struct {
+	Fatal bool `json:"fatal,omitempty"`
+}
+
+type pipelineEvent struct {
+	ID        string       `json:"id"`
+	EventType string       `json:"event_type"`
+	Level     string       `json:"level"`
+	Message   string       `json:"message"`
+	Timestamp string       `json:"timestamp"`
+	Origin    *eventOrigin `json:"origin,omitempty"`
+	Error     *eventError  `json:"error,omitempty"`
+}
+
+type pipelineEventsRequest struct {
+	MaxResults int    `url:"max_results,omitempty"`
+	OrderBy    string `url:"order_by,omitempty"`
+	Filter     string `url:"filter,omitempty"`
+	PageToken  string `url:"page_token,omitempty"`
+}
+
+type pipelineEventsResponse struct {
+	Events        []pipelineEvent `json:"events"`
+	NextPageToken string          `json:"next_page_token,omitempty"`
+}
+
+// listEvents pages through the event log from the most recent events, until callback returns false
+func (a pipelinesAPI) listEvents(id, filter string, callback func(pipelineEvent) bool) error {
+	req := pipelineEventsRequest{
+		MaxResults: 100,
+		OrderBy:    "timestamp desc",
+		Filter:     filter,
+	}
+	for {
+		var resp pipelineEventsResponse
+		err := a.client.Get(a.ctx, fmt.Sprintf("/pipelines/%s/events", id), req, &resp)
+		if err != nil {
+			return err
+		}
+		for _, e := range resp.Events {
+			if !callback(e) {
+				return nil
+			}
+		}
+		if resp.NextPageToken == "" {
+			return nil
+		}
+		// page token cannot be combined with any other field, except max_results
+		req = pipelineEventsRequest{
+			MaxResults: req.MaxResults,
+			PageToken:  resp.NextPageToken,
+		}
+	}
+}
+
+// PipelineEventSummary is the subset of event log entry attributes exposed by data source
+type PipelineEventSummary struct {
+	ID        string `json:"id"`
+	EventType string `json:"event_type"`
+	Level     string `json:"level"`
+	Message   string `json:"message,omitempty"`
+	Timestamp string `json:"timestamp"`
+	UpdateID  string `json:"update_id,omitempty"`
+	Fatal     bool   `json:"fatal,omitempty"`
+}
+
+// DataSourcePipelineEvents returns the most recent entries of pipeline event log
+func DataSourcePipelineEvents() *schema.Resource {
+	type eventsFilter struct {
+		PipelineID string                 `json:"pipeline_id"`
+		Level      string                 `json:"level,omitempty"`
+		EventType  string                 `json:"event_type,omitempty"`
+		UpdateID   string                 `json:"update_id,omitempty"`
+		MaxResults int                    `json:"max_results,omitempty" tf:"default:100"`
+		Events     []PipelineEventSummary `json:"events,omitempty" tf:"computed"`
+	}
+	s := common.StructToSchema(eventsFilter{}, func(
+		s map[string]*schema.Schema) map[string]*schema.Schema {
+		s["level"].ValidateFunc = validation.StringInSlice([]string{
+			"INFO", "WARN", "ERROR", "METRICS"}, false)
+		s["max_results"].ValidateFunc = validation.IntAtLeast(1)
+		return s
+	})
+	return &schema.Resource{
+		Schema: s,
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			var this eventsFilter
+			err := common.DataToStructPointer(d, s, &this)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			// only level is supported by the filter expression of the API,
+			// other criteria are applied on each page
+			filter := ""
+			if this.Level != "" {
+				filter = fmt.Sprintf("level='%s'", this.Level)
+			}
+			this.Events = []PipelineEventSummary{}
+			err = newPipelinesAPI(ctx, m).listEvents(this.PipelineID, filter, func(e pipelineEvent) bool {
+				if this.EventType != "" && e.EventType != this.EventType {
+					return true
+				}
+				updateID := ""
+				if e.Origin != nil {
+					updateID = e.Origin.UpdateID
+				}
+				if this.UpdateID != "" && updateID != this.UpdateID {
+					return true
+				}
+				this.Events = append(this.Events, PipelineEventSummary{
+					ID:        e.ID,
+					EventType: e.EventType,
+					Level:     e.Level,
+					Message:   e.Message,
+					Timestamp: e.Timestamp,
+					UpdateID:  updateID,
+					Fatal:     e.Error != nil && e.Error.Fatal,
+				})
+				return len(this.Events) < this.MaxResults
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			d.SetId(this.PipelineID)
+			err = common.StructToData(this, s, d)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,151 @@
+package pipelines
+
+import (
+	"testing"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+	"github.com/databrickslabs/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataSourcePipelineEvents(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/pipelines/abcd/events?filter=level%3D%27ERROR%27&max_results=100&order_by=timestamp+desc",
+				Response: pipelineEventsResponse{
+					Events: []pipelineEvent{
+						{
+							ID:        "e3",
+							EventType: "flow_progress",
+							Level:     "ERROR",
+							Message:   "Flow 'sales' has failed",
+							Timestamp: "2021-11-03T10:12:00.000Z",
+							Origin: &eventOrigin{
+								UpdateID: "u2",
+							},
+						},
+						{
+							ID:        "e2",
+							EventType: "update_progress",
+							Level:     "ERROR",
+							Message:   "Update u2 has failed",
+							Timestamp: "2021-11-03T10:11:00.000Z",
+							Origin: &eventOrigin{
+								UpdateID: "u2",
+							},
+							Error: &eventError{
+								Fatal: true,
+							},
+						},
+					},
+					NextPageToken: "next",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/pipelines/abcd/events?max_results=100&page_token=next",
+				Response: pipelineEventsResponse{
+					Events: []pipelineEvent{
+						{
+							ID:        "e1",
+							EventType: "update_progress",
+							Level:     "ERROR",
+							Message:   "Update u1 has failed",
+							Timestamp: "2021-11-02T10:11:00.000Z",
+							Origin: &eventOrigin{
+								UpdateID: "u1",
+							},
+						},
+					},
+				},
+			},
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourcePipelineEvents(),
+		ID:          ".",
+		HCL: `pipeline_id = "abcd"
+		level = "ERROR"
+		event_type = "update_progress"`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "abcd", d.Id())
+	assert.Equal(t, 2, d.Get("events.#"))
+	assert.Equal(t, "e2", d.Get("events.0.id"))
+	assert.Equal(t, "u2", d.Get("events.0.update_id"))
+	assert.Equal(t, true, d.Get("events.0.fatal"))
+	assert.Equal(t, "Update u1 has failed", d.Get("events.1.message"))
+}
+
+func TestDataSourcePipelineEvents_MaxResults(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/pipelines/abcd/events?max_results=100&order_by=timestamp+desc",
+				Response: pipelineEventsResponse{
+					Events: []pipelineEvent{
+						{
+							ID:        "e2",
+							EventType: "update_progress",
+							Level:     "INFO",
+							Origin: &eventOrigin{
+								UpdateID: "u2",
+							},
+						},
+						{
+							ID:        "e1",
+							EventType: "update_progress",
+							Level:     "INFO",
+							Origin: &eventOrigin{
+								UpdateID: "u1",
+							},
+						},
+						{
+							ID:        "e0",
+							EventType: "update_progress",
+							Level:     "INFO",
+							Origin: &eventOrigin{
+								UpdateID: "u1",
+							},
+						},
+					},
+					NextPageToken: "next",
+				},
+			},
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourcePipelineEvents(),
+		ID:          ".",
+		HCL: `pipeline_id = "abcd"
+		update_id = "u1"
+		max_results = 1`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, 1, d.Get("events.#"))
+	assert.Equal(t, "e1", d.Get("events.0.id"))
+}
+
+func TestDataSourcePipelineEvents_Error(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/pipelines/abcd/events?max_results=100&order_by=timestamp+desc",
+				Response: common.APIErrorBody{
+					ErrorCode: "RESOURCE_DOES_NOT_EXIST",
+					Message:   "Pipeline abcd does not exist",
+				},
+				Status: 404,
+			},
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourcePipelineEvents(),
+		ID:          ".",
+		HCL:         `pipeline_id = "abcd"`,
+	}.ExpectError(t, "Pipeline abcd does not exist")
+}
@@ -0,0 +1,94 @@
+package pipelines
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+type pipelineUpdatesRequest struct {
+	MaxResults int    `url:"max_results,omitempty"`
+	PageToken  string `url:"page_token,omitempty"`
+}
+
+type pipelineUpdatesResponse struct {
+	Updates       []pipelineUpdate `json:"updates"`
+	NextPageToken string           `json:"next_page_token,omitempty"`
+}
+
+// listUpdates returns up to limit most recent updates of the pipeline
+func (a pipelinesAPI) listUpdates(id string, limit int) ([]pipelineUpdate, error) {
+	req := pipelineUpdatesRequest{
+		MaxResults: limit,
+	}
+	updates := []pipelineUpdate{}
+	for {
+		var resp pipelineUpdatesResponse
+		err := a.client.Get(a.ctx, fmt.Sprintf("/pipelines/%s/updates", id), req, &resp)
+		if err != nil {
+			return nil, err
+		}
+		updates = append(updates, resp.Updates...)
+		if len(updates) >= limit {
+			return updates[:limit], nil
+		}
+		if resp.NextPageToken == "" {
+			return updates, nil
+		}
+		req.PageToken = resp.NextPageToken
+	}
+}
+
+// DataSourcePipelineUpdates returns the most recent updates of a pipeline
+func DataSourcePipelineUpdates() *schema.Resource {
+	type pipelineUpdates struct {
+		PipelineID           string           `json:"pipeline_id"`
+		MaxResults           int              `json:"max_results,omitempty" tf:"default:25"`
+		RequireLatestSuccess bool             `json:"require_latest_success,omitempty"`
+		LatestUpdateID       string           `json:"latest_update_id,omitempty" tf:"computed"`
+		LatestState          string           `json:"latest_state,omitempty" tf:"computed"`
+		Updates              []pipelineUpdate `json:"updates,omitempty" tf:"computed"`
+	}
+	s := common.StructToSchema(pipelineUpdates{}, func(
+		s map[string]*schema.Schema) map[string]*schema.Schema {
+		s["max_results"].ValidateFunc = validation.IntBetween(1, 100)
+		return s
+	})
+	return &schema.Resource{
+		Schema: s,
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			var this pipelineUpdates
+			err := common.DataToStructPointer(d, s, &this)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			this.Updates, err = newPipelinesAPI(ctx, m).listUpdates(this.PipelineID, this.MaxResults)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			this.LatestUpdateID = ""
+			this.LatestState = ""
+			if len(this.Updates) > 0 {
+				this.LatestUpdateID = this.Updates[0].UpdateID
+				this.LatestState = string(this.Updates[0].State)
+			}
+			if this.RequireLatestSuccess && this.LatestState != string(UpdateStateCompleted) {
+				if this.LatestUpdateID == "" {
+					return diag.Errorf("pipeline %s has no updates", this.PipelineID)
+				}
+				return diag.Errorf("latest update %s of pipeline %s is %s, not %s",
+					this.LatestUpdateID, this.PipelineID, this.LatestState, UpdateStateCompleted)
+			}
+			d.SetId(this.PipelineID)
+			err = common.StructToData(this, s, d)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,99 @@
+package pipelines
+
+import (
+	"testing"
+
+	"github.com/databrickslabs/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+)
+
+func pipelineUpdatesFixtures(latest UpdateState) []qa.HTTPFixture {
+	return []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/pipelines/abcd/updates?max_results=2",
+			Response: pipelineUpdatesResponse{
+				Updates: []pipelineUpdate{
+					{
+						PipelineID: "abcd",
+						UpdateID:   "u3",
+						State:      latest,
+						Cause:      "API_CALL",
+					},
+				},
+				NextPageToken: "next",
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/pipelines/abcd/updates?max_results=2&page_token=next",
+			Response: pipelineUpdatesResponse{
+				Updates: []pipelineUpdate{
+					{
+						PipelineID: "abcd",
+						UpdateID:   "u2",
+						State:      UpdateStateCompleted,
+						Cause:      "API_CALL",
+					},
+					{
+						PipelineID: "abcd",
+						UpdateID:   "u1",
+						State:      UpdateStateCompleted,
+						Cause:      "API_CALL",
+					},
+				},
+				NextPageToken: "more",
+			},
+		},
+	}
+}
+
+func TestDataSourcePipelineUpdates(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures:    pipelineUpdatesFixtures(UpdateStateCompleted),
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourcePipelineUpdates(),
+		ID:          ".",
+		HCL: `pipeline_id = "abcd"
+		max_results = 2
+		require_latest_success = true`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "abcd", d.Id())
+	assert.Equal(t, "u3", d.Get("latest_update_id"))
+	assert.Equal(t, "COMPLETED", d.Get("latest_state"))
+	assert.Equal(t, 2, d.Get("updates.#"))
+	assert.Equal(t, "u2", d.Get("updates.1.update_id"))
+}
+
+func TestDataSourcePipelineUpdates_LatestFailed(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures:    pipelineUpdatesFixtures(UpdateStateFailed),
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourcePipelineUpdates(),
+		ID:          ".",
+		HCL: `pipeline_id = "abcd"
+		max_results = 2
+		require_latest_success = true`,
+	}.ExpectError(t, "latest update u3 of pipeline abcd is FAILED, not COMPLETED")
+}
+
+func TestDataSourcePipelineUpdates_NoUpdates(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/pipelines/abcd/updates?max_results=25",
+				Response: pipelineUpdatesResponse{},
+			},
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourcePipelineUpdates(),
+		ID:          ".",
+		HCL: `pipeline_id = "abcd"
+		require_latest_success = true`,
+	}.ExpectError(t, "pipeline abcd has no updates")
+}
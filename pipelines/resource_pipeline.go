@@ -4,10 +4,12 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/databrickslabs/terraform-provider-databricks/clusters"
 	"github.com/databrickslabs/terraform-provider-databricks/common"
@@ -27,10 +29,13 @@ type pipelineCluster struct {
 	NumWorkers int32               `json:"num_workers,omitempty" tf:"group:size"`
 	Autoscale  *clusters.AutoScale `json:"autoscale,omitempty" tf:"group:size"`
 
-	NodeTypeID       string                  `json:"node_type_id,omitempty" tf:"group:node_type,computed"`
-	DriverNodeTypeID string                  `json:"driver_node_type_id,omitempty" tf:"conflicts:instance_pool_id,computed"`
-	InstancePoolID   string                  `json:"instance_pool_id,omitempty" tf:"group:node_type"`
-	AwsAttributes    *clusters.AwsAttributes `json:"aws_attributes,omitempty" tf:"conflicts:instance_pool_id"`
+	NodeTypeID       string                    `json:"node_type_id,omitempty" tf:"group:node_type,computed"`
+	DriverNodeTypeID string                    `json:"driver_node_type_id,omitempty" tf:"conflicts:instance_pool_id,computed"`
+	InstancePoolID   string                    `json:"instance_pool_id,omitempty" tf:"group:node_type"`
+	PolicyID         string                    `json:"policy_id,omitempty"`
+	AwsAttributes    *clusters.AwsAttributes   `json:"aws_attributes,omitempty" tf:"conflicts:instance_pool_id"`
+	AzureAttributes  *clusters.AzureAttributes `json:"azure_attributes,omitempty" tf:"conflicts:instance_pool_id"`
+	GcpAttributes    *clusters.GcpAttributes   `json:"gcp_attributes,omitempty" tf:"conflicts:instance_pool_id"`
 
 	SparkConf    map[string]string `json:"spark_conf,omitempty"`
 	SparkEnvVars map[string]string `json:"spark_env_vars,omitempty"`
@@ -57,6 +62,11 @@ type filters struct {
 	Exclude []string `json:"exclude,omitempty"`
 }
 
+type notification struct {
+	EmailRecipients []string `json:"email_recipients"`
+	Alerts          []string `json:"alerts"`
+}
+
 type pipelineSpec struct {
 	ID                  string            `json:"id,omitempty" tf:"computed"`
 	Name                string            `json:"name,omitempty"`
@@ -66,8 +76,51 @@ type pipelineSpec struct {
 	Libraries           []pipelineLibrary `json:"libraries,omitempty" tf:"slice_set,alias:library"`
 	Filters             *filters          `json:"filters"`
 	Continuous          bool              `json:"continuous,omitempty"`
+	Development         bool              `json:"development,omitempty"`
 	AllowDuplicateNames bool              `json:"allow_duplicate_names,omitempty"`
 	Target              string            `json:"target,omitempty"`
+	Edition             string            `json:"edition,omitempty" tf:"computed"`
+	Channel             string            `json:"channel,omitempty" tf:"computed"`
+	Photon              bool              `json:"photon,omitempty"`
+	Notifications       []notification    `json:"notifications,omitempty" tf:"alias:notification"`
+}
+
+// pipelineUpdateRequest starts an update of all tables or only the selected ones
+type pipelineUpdateRequest struct {
+	FullRefresh          bool     `json:"full_refresh,omitempty"`
+	RefreshSelection     []string `json:"refresh_selection,omitempty"`
+	FullRefreshSelection []string `json:"full_refresh_selection,omitempty"`
+}
+
+type pipelineUpdateResponse struct {
+	UpdateID string `json:"update_id"`
+}
+
+// UpdateState ...
+type UpdateState string
+
+// Constants for UpdateStates
+const (
+	UpdateStateRunning   UpdateState = "RUNNING"
+	UpdateStateCompleted UpdateState = "COMPLETED"
+	UpdateStateFailed    UpdateState = "FAILED"
+	UpdateStateCanceled  UpdateState = "CANCELED"
+)
+
+type pipelineUpdate struct {
+	PipelineID           string      `json:"pipeline_id"`
+	UpdateID             string      `json:"update_id"`
+	State                UpdateState `json:"state"`
+	Cause                string      `json:"cause,omitempty"`
+	ClusterID            string      `json:"cluster_id,omitempty"`
+	CreationTime         int64       `json:"creation_time,omitempty"`
+	FullRefresh          bool        `json:"full_refresh,omitempty"`
+	RefreshSelection     []string    `json:"refresh_selection,omitempty"`
+	FullRefreshSelection []string    `json:"full_refresh_selection,omitempty"`
+}
+
+type pipelineUpdateWrapper struct {
+	Update pipelineUpdate `json:"update"`
 }
 
 type createPipelineResponse struct {
@@ -172,6 +225,42 @@ func (a pipelinesAPI) delete(id string, timeout time.Duration) error {
 		})
 }
 
+// startUpdate starts an update of the pipeline and waits until it completes for triggered pipelines,
+// or until it's running for continuous pipelines. Health of the pipeline is checked afterwards.
+func (a pipelinesAPI) startUpdate(id string, r pipelineUpdateRequest, continuous bool, timeout time.Duration) error {
+	var resp pipelineUpdateResponse
+	err := a.client.Post(a.ctx, fmt.Sprintf("/pipelines/%s/updates", id), r, &resp)
+	if err != nil {
+		return err
+	}
+	err = resource.RetryContext(a.ctx, timeout,
+		func() *resource.RetryError {
+			var w pipelineUpdateWrapper
+			err := a.client.Get(a.ctx, fmt.Sprintf("/pipelines/%s/updates/%s", id, resp.UpdateID), nil, &w)
+			if err != nil {
+				return resource.NonRetryableError(err)
+			}
+			switch w.Update.State {
+			case UpdateStateCompleted:
+				return nil
+			case UpdateStateRunning:
+				if continuous {
+					return nil
+				}
+			case UpdateStateFailed, UpdateStateCanceled:
+				return resource.NonRetryableError(fmt.Errorf("update %s of pipeline %s is %s",
+					resp.UpdateID, id, w.Update.State))
+			}
+			message := fmt.Sprintf("Update %s of pipeline %s is %s", resp.UpdateID, id, w.Update.State)
+			log.Printf("[DEBUG] %s", message)
+			return resource.RetryableError(fmt.Errorf(message))
+		})
+	if err != nil {
+		return err
+	}
+	return a.waitForState(id, timeout, StateRunning)
+}
+
 func (a pipelinesAPI) waitForState(id string, timeout time.Duration, desiredState PipelineState) error {
 	return resource.RetryContext(a.ctx, timeout,
 		func() *resource.RetryError {
@@ -217,9 +306,77 @@ func adjustPipelineResourceSchema(m map[string]*schema.Schema) map[string]*schem
 		Computed: true,
 	}
 
+	m["edition"].ValidateFunc = validation.StringInSlice([]string{"CORE", "PRO", "ADVANCED"}, true)
+	m["edition"].DiffSuppressFunc = caseInsensitiveDiffSuppress
+	m["channel"].ValidateFunc = validation.StringInSlice([]string{"CURRENT", "PREVIEW"}, true)
+	m["channel"].DiffSuppressFunc = caseInsensitiveDiffSuppress
+
+	notification, _ := m["notification"].Elem.(*schema.Resource)
+	notification.Schema["email_recipients"].MinItems = 1
+	notification.Schema["alerts"].MinItems = 1
+	notification.Schema["alerts"].Elem = &schema.Schema{
+		Type: schema.TypeString,
+		ValidateFunc: validation.StringInSlice([]string{
+			"on-update-success",
+			"on-update-failure",
+			"on-update-fatal-failure",
+			"on-flow-failure",
+		}, false),
+	}
+
+	m["run_update_on_change"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: common.StructToSchema(pipelineUpdateRequest{},
+				func(m map[string]*schema.Schema) map[string]*schema.Schema {
+					return m
+				}),
+		},
+	}
+
 	return m
 }
 
+func caseInsensitiveDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+	return strings.EqualFold(old, new)
+}
+
+// updateOnChange returns update request, if pipeline has to be updated after spec edits
+func updateOnChange(d *schema.ResourceData) (r pipelineUpdateRequest, ok bool) {
+	raw := d.Get("run_update_on_change").([]interface{})
+	if len(raw) == 0 {
+		return
+	}
+	ok = true
+	m, _ := raw[0].(map[string]interface{})
+	if m == nil {
+		return
+	}
+	r.FullRefresh = m["full_refresh"].(bool)
+	for _, v := range m["refresh_selection"].([]interface{}) {
+		r.RefreshSelection = append(r.RefreshSelection, v.(string))
+	}
+	for _, v := range m["full_refresh_selection"].([]interface{}) {
+		r.FullRefreshSelection = append(r.FullRefreshSelection, v.(string))
+	}
+	return
+}
+
+// specChanged checks if anything besides provider-only fields was changed
+func specChanged(d *schema.ResourceData, pipelineSchema map[string]*schema.Schema) bool {
+	for k := range pipelineSchema {
+		if k == "run_update_on_change" || k == "url" {
+			continue
+		}
+		if d.HasChange(k) {
+			return true
+		}
+	}
+	return false
+}
+
 // ResourcePipeline defines the Terraform resource for pipelines.
 func ResourcePipeline() *schema.Resource {
 	var pipelineSchema = common.StructToSchema(pipelineSpec{}, adjustPipelineResourceSchema)
@@ -255,7 +412,20 @@ func ResourcePipeline() *schema.Resource {
 			if err := common.DataToStructPointer(d, pipelineSchema, &s); err != nil {
 				return err
 			}
-			return newPipelinesAPI(ctx, c).update(d.Id(), s, d.Timeout(schema.TimeoutUpdate))
+			if !specChanged(d, pipelineSchema) {
+				return nil
+			}
+			api := newPipelinesAPI(ctx, c)
+			err := api.update(d.Id(), s, d.Timeout(schema.TimeoutUpdate))
+			if err != nil {
+				return err
+			}
+			r, ok := updateOnChange(d)
+			if !ok {
+				return nil
+			}
+			log.Printf("[INFO] Starting update of pipeline %s after changes in its spec", d.Id())
+			return api.startUpdate(d.Id(), r, s.Continuous, d.Timeout(schema.TimeoutUpdate))
 		},
 		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			api := newPipelinesAPI(ctx, c)
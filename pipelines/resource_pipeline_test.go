@@ -3,6 +3,7 @@ package pipelines
 import (
 	"testing"
 
+	"github.com/databrickslabs/terraform-provider-databricks/clusters"
 	"github.com/databrickslabs/terraform-provider-databricks/common"
 	"github.com/databrickslabs/terraform-provider-databricks/libraries"
 
@@ -507,3 +508,242 @@ func TestResourcePipelineDelete_Error(t *testing.T) {
 	qa.AssertErrorStartsWith(t, err, "Internal error happened")
 	assert.Equal(t, "abcd", d.Id())
 }
+
+func TestResourcePipelineCreate_EditionChannelNotifications(t *testing.T) {
+	state := StateRunning
+	spec := pipelineSpec{
+		Name:    "test",
+		Storage: "/test/storage",
+		Clusters: []pipelineCluster{
+			{
+				Label:    "default",
+				PolicyID: "abc",
+				AzureAttributes: &clusters.AzureAttributes{
+					Availability: "SPOT_WITH_FALLBACK_AZURE",
+				},
+			},
+		},
+		Libraries: []pipelineLibrary{
+			{
+				Notebook: &notebookLibrary{
+					Path: "/DLT",
+				},
+			},
+		},
+		Filters:     &filters{},
+		Development: true,
+		Edition:     "PRO",
+		Channel:     "PREVIEW",
+		Photon:      true,
+		Notifications: []notification{
+			{
+				EmailRecipients: []string{"oncall@example.com"},
+				Alerts:          []string{"on-update-failure", "on-flow-failure"},
+			},
+		},
+	}
+	request := spec
+	request.Edition = "pro"
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:          "POST",
+				Resource:        "/api/2.0/pipelines",
+				ExpectedRequest: request,
+				Response: createPipelineResponse{
+					PipelineID: "abcd",
+				},
+			},
+			{
+				Method:       "GET",
+				Resource:     "/api/2.0/pipelines/abcd",
+				ReuseRequest: true,
+				Response: pipelineInfo{
+					PipelineID: "abcd",
+					Spec:       &spec,
+					State:      &state,
+				},
+			},
+		},
+		Create:   true,
+		Resource: ResourcePipeline(),
+		HCL: `name = "test"
+		storage = "/test/storage"
+		cluster {
+			label = "default"
+			policy_id = "abc"
+			azure_attributes {
+				availability = "SPOT_WITH_FALLBACK_AZURE"
+			}
+		}
+		library {
+			notebook {
+				path = "/DLT"
+			}
+		}
+		filters {}
+		development = true
+		edition = "pro"
+		channel = "PREVIEW"
+		photon = true
+		notification {
+			email_recipients = ["oncall@example.com"]
+			alerts = ["on-update-failure", "on-flow-failure"]
+		}`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "PRO", d.Get("edition"))
+	assert.Equal(t, "oncall@example.com", d.Get("notification.0.email_recipients.0"))
+}
+
+func TestResourcePipelineCreate_InvalidAlert(t *testing.T) {
+	qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourcePipeline(),
+		HCL: `name = "test"
+		library {
+			jar = "jar"
+		}
+		filters {}
+		notification {
+			email_recipients = ["oncall@example.com"]
+			alerts = ["on-coffee-break"]
+		}`,
+	}.ExpectError(t, "invalid config supplied. [notification.#.alerts.#] expected "+
+		"notification.0.alerts.0 to be one of [on-update-success on-update-failure "+
+		"on-update-fatal-failure on-flow-failure], got on-coffee-break")
+}
+
+func pipelineUpdateFixtures(updateState UpdateState) []qa.HTTPFixture {
+	state := StateIdle
+	spec := pipelineSpec{
+		ID:      "abcd",
+		Name:    "test",
+		Storage: "/test/storage",
+		Libraries: []pipelineLibrary{
+			{
+				Jar: "jar",
+			},
+		},
+		Filters: &filters{},
+	}
+	return []qa.HTTPFixture{
+		{
+			Method:          "PUT",
+			Resource:        "/api/2.0/pipelines/abcd",
+			ExpectedRequest: spec,
+		},
+		{
+			Method:       "GET",
+			Resource:     "/api/2.0/pipelines/abcd",
+			ReuseRequest: true,
+			Response: pipelineInfo{
+				PipelineID: "abcd",
+				Spec:       &spec,
+				State:      &state,
+			},
+		},
+		{
+			Method:   "POST",
+			Resource: "/api/2.0/pipelines/abcd/updates",
+			ExpectedRequest: pipelineUpdateRequest{
+				RefreshSelection: []string{"sales"},
+			},
+			Response: pipelineUpdateResponse{
+				UpdateID: "u1",
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/pipelines/abcd/updates/u1",
+			Response: pipelineUpdateWrapper{
+				Update: pipelineUpdate{
+					PipelineID: "abcd",
+					UpdateID:   "u1",
+					State:      UpdateStateRunning,
+				},
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/pipelines/abcd/updates/u1",
+			Response: pipelineUpdateWrapper{
+				Update: pipelineUpdate{
+					PipelineID: "abcd",
+					UpdateID:   "u1",
+					State:      updateState,
+				},
+			},
+		},
+	}
+}
+
+func TestResourcePipelineUpdate_RunUpdateOnChange(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: pipelineUpdateFixtures(UpdateStateCompleted),
+		Resource: ResourcePipeline(),
+		HCL: `name = "test"
+		storage = "/test/storage"
+		library {
+			jar = "jar"
+		}
+		filters {}
+		run_update_on_change {
+			refresh_selection = ["sales"]
+		}`,
+		Update: true,
+		ID:     "abcd",
+	}.ApplyNoError(t)
+}
+
+func TestResourcePipelineUpdate_RunUpdateOnChangeFailed(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: pipelineUpdateFixtures(UpdateStateFailed),
+		Resource: ResourcePipeline(),
+		HCL: `name = "test"
+		storage = "/test/storage"
+		library {
+			jar = "jar"
+		}
+		filters {}
+		run_update_on_change {
+			refresh_selection = ["sales"]
+		}`,
+		Update: true,
+		ID:     "abcd",
+	}.ExpectError(t, "update u1 of pipeline abcd is FAILED")
+}
+
+func TestResourcePipelineUpdate_OnlyRunUpdateOnChange(t *testing.T) {
+	state := StateIdle
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/pipelines/abcd",
+				Response: pipelineInfo{
+					PipelineID: "abcd",
+					Spec: &pipelineSpec{
+						ID:      "abcd",
+						Name:    "test",
+						Filters: &filters{},
+					},
+					State: &state,
+				},
+			},
+		},
+		Resource: ResourcePipeline(),
+		InstanceState: map[string]string{
+			"id":        "abcd",
+			"name":      "test",
+			"filters.#": "1",
+		},
+		HCL: `name = "test"
+		filters {}
+		run_update_on_change {
+			full_refresh = true
+		}`,
+		Update: true,
+		ID:     "abcd",
+	}.ApplyNoError(t)
+}
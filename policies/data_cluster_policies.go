@@ -0,0 +1,74 @@
+package policies
+
+import (
+	"context"
+	"regexp"
+	"sort"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ClusterPolicySummary is the subset of policy attributes exposed by listing data source
+type ClusterPolicySummary struct {
+	PolicyID   string `json:"policy_id,omitempty"`
+	Name       string `json:"name,omitempty"`
+	Definition string `json:"definition,omitempty"`
+}
+
+// DataSourceClusterPolicies returns cluster policies matching name regex
+func DataSourceClusterPolicies() *schema.Resource {
+	type policiesFilter struct {
+		NameRegex string                 `json:"name_regex,omitempty"`
+		IDs       []string               `json:"ids,omitempty" tf:"computed,slice_set"`
+		Policies  []ClusterPolicySummary `json:"policies,omitempty" tf:"computed"`
+	}
+	s := common.StructToSchema(policiesFilter{}, func(
+		s map[string]*schema.Schema) map[string]*schema.Schema {
+		// nolint once SDKv2 has Diagnostics-returning validators, change
+		s["name_regex"].ValidateFunc = validation.StringIsValidRegExp
+		return s
+	})
+	return &schema.Resource{
+		Schema: s,
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			var this policiesFilter
+			err := common.DataToStructPointer(d, s, &this)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			nameRegex, err := regexp.Compile(this.NameRegex)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			list, err := NewClusterPoliciesAPI(ctx, m).List()
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			sort.Slice(list.Policies, func(i, j int) bool {
+				return list.Policies[i].PolicyID < list.Policies[j].PolicyID
+			})
+			this.IDs = []string{}
+			this.Policies = []ClusterPolicySummary{}
+			for _, policy := range list.Policies {
+				if !nameRegex.MatchString(policy.Name) {
+					continue
+				}
+				this.IDs = append(this.IDs, policy.PolicyID)
+				this.Policies = append(this.Policies, ClusterPolicySummary{
+					PolicyID:   policy.PolicyID,
+					Name:       policy.Name,
+					Definition: policy.Definition,
+				})
+			}
+			d.SetId("_")
+			err = common.StructToData(this, s, d)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,48 @@
+package policies
+
+import (
+	"testing"
+
+	"github.com/databrickslabs/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataSourceClusterPolicies(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/policies/clusters/list",
+				Response: ClusterPolicyList{
+					Policies: []ClusterPolicy{
+						{
+							PolicyID:   "b",
+							Name:       "Shared Compute",
+							Definition: "{}",
+						},
+						{
+							PolicyID: "a",
+							Name:     "Personal Compute",
+						},
+					},
+					TotalCount: 2,
+				},
+			},
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceClusterPolicies(),
+		ID:          ".",
+		HCL:         `name_regex = "^Shared"`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "_", d.Id())
+	assert.Equal(t, 1, d.Get("ids.#"))
+	assert.Equal(t, "b", d.Get("policies.0.policy_id"))
+	assert.Equal(t, "Shared Compute", d.Get("policies.0.name"))
+	assert.Equal(t, "{}", d.Get("policies.0.definition"))
+}
+
+func TestDataSourceClusterPolicies_Error(t *testing.T) {
+	qa.ResourceCornerCases(t, DataSourceClusterPolicies(), qa.CornerCaseID("_"))
+}
@@ -35,6 +35,12 @@ type ClusterPoliciesAPI struct {
 	context context.Context
 }
 
+// ClusterPolicyList is the response of list call
+type ClusterPolicyList struct {
+	Policies   []ClusterPolicy `json:"policies,omitempty"`
+	TotalCount int64           `json:"total_count,omitempty"`
+}
+
 type policyIDWrapper struct {
 	PolicyID string `json:"policy_id,omitempty" url:"policy_id,omitempty"`
 }
@@ -64,6 +70,12 @@ func (a ClusterPoliciesAPI) Get(policyID string) (policy ClusterPolicy, err erro
 	return
 }
 
+// List returns all cluster policies available to the user
+func (a ClusterPoliciesAPI) List() (l ClusterPolicyList, err error) {
+	err = a.client.Get(a.context, "/policies/clusters/list", nil, &l)
+	return
+}
+
 // Delete removes cluster policy
 func (a ClusterPoliciesAPI) Delete(policyID string) error {
 	return a.client.Post(a.context, "/policies/clusters/delete", policyIDWrapper{policyID}, nil)
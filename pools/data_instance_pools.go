@@ -0,0 +1,87 @@
+package pools
+
+import (
+	"context"
+	"regexp"
+	"sort"
+
+	"github.com/databrickslabs/terraform-provider-databricks/clusters"
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// InstancePoolSummary is the subset of instance pool attributes exposed by listing data source
+type InstancePoolSummary struct {
+	InstancePoolID   string            `json:"instance_pool_id,omitempty"`
+	InstancePoolName string            `json:"instance_pool_name,omitempty"`
+	NodeTypeID       string            `json:"node_type_id,omitempty"`
+	State            string            `json:"state,omitempty"`
+	MinIdleInstances int32             `json:"min_idle_instances,omitempty"`
+	MaxCapacity      int32             `json:"max_capacity,omitempty"`
+	CustomTags       map[string]string `json:"custom_tags,omitempty"`
+}
+
+// DataSourceInstancePools returns instance pools matching name regex and custom tags
+func DataSourceInstancePools() *schema.Resource {
+	type poolsFilter struct {
+		NameRegex     string                `json:"name_regex,omitempty"`
+		Tags          map[string]string     `json:"tags,omitempty"`
+		IDs           []string              `json:"ids,omitempty" tf:"computed,slice_set"`
+		InstancePools []InstancePoolSummary `json:"instance_pools,omitempty" tf:"computed"`
+	}
+	s := common.StructToSchema(poolsFilter{}, func(
+		s map[string]*schema.Schema) map[string]*schema.Schema {
+		// nolint once SDKv2 has Diagnostics-returning validators, change
+		s["name_regex"].ValidateFunc = validation.StringIsValidRegExp
+		return s
+	})
+	return &schema.Resource{
+		Schema: s,
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			var this poolsFilter
+			err := common.DataToStructPointer(d, s, &this)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			nameRegex, err := regexp.Compile(this.NameRegex)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			list, err := NewInstancePoolsAPI(ctx, m).List()
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			sort.Slice(list.InstancePools, func(i, j int) bool {
+				return list.InstancePools[i].InstancePoolID < list.InstancePools[j].InstancePoolID
+			})
+			this.IDs = []string{}
+			this.InstancePools = []InstancePoolSummary{}
+			for _, pool := range list.InstancePools {
+				if !nameRegex.MatchString(pool.InstancePoolName) {
+					continue
+				}
+				if !clusters.MatchesTags(pool.CustomTags, this.Tags) {
+					continue
+				}
+				this.IDs = append(this.IDs, pool.InstancePoolID)
+				this.InstancePools = append(this.InstancePools, InstancePoolSummary{
+					InstancePoolID:   pool.InstancePoolID,
+					InstancePoolName: pool.InstancePoolName,
+					NodeTypeID:       pool.NodeTypeID,
+					State:            pool.State,
+					MinIdleInstances: pool.MinIdleInstances,
+					MaxCapacity:      pool.MaxCapacity,
+					CustomTags:       pool.CustomTags,
+				})
+			}
+			d.SetId("_")
+			err = common.StructToData(this, s, d)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			return nil
+		},
+	}
+}
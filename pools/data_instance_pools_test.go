@@ -0,0 +1,60 @@
+package pools
+
+import (
+	"testing"
+
+	"github.com/databrickslabs/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataSourceInstancePools(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/instance-pools/list",
+				Response: InstancePoolList{
+					InstancePools: []InstancePoolAndStats{
+						{
+							InstancePoolID:   "b",
+							InstancePoolName: "shared-small",
+							NodeTypeID:       "i3.xlarge",
+							State:            "ACTIVE",
+							MaxCapacity:      10,
+							CustomTags: map[string]string{
+								"team": "data",
+							},
+						},
+						{
+							InstancePoolID:   "a",
+							InstancePoolName: "shared-large",
+							CustomTags: map[string]string{
+								"team": "ml",
+							},
+						},
+					},
+				},
+			},
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceInstancePools(),
+		ID:          ".",
+		HCL: `
+		name_regex = "^shared-"
+		tags = {
+			team = "data"
+		}`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "_", d.Id())
+	assert.Equal(t, 1, d.Get("ids.#"))
+	assert.Equal(t, "b", d.Get("instance_pools.0.instance_pool_id"))
+	assert.Equal(t, "i3.xlarge", d.Get("instance_pools.0.node_type_id"))
+	assert.Equal(t, "ACTIVE", d.Get("instance_pools.0.state"))
+	assert.Equal(t, 10, d.Get("instance_pools.0.max_capacity"))
+}
+
+func TestDataSourceInstancePools_Error(t *testing.T) {
+	qa.ResourceCornerCases(t, DataSourceInstancePools(), qa.CornerCaseID("_"))
+}
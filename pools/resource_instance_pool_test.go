@@ -116,6 +116,86 @@ func TestResourceInstancePoolCreate(t *testing.T) {
 	assert.Equal(t, "abc", d.Id())
 }
 
+func TestResourceInstancePoolCreate_Gcp(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/instance-pools/create",
+				ExpectedRequest: InstancePool{
+					InstancePoolName:                   "Shared Pool",
+					MaxCapacity:                        100,
+					NodeTypeID:                         "n1-standard-4",
+					IdleInstanceAutoTerminationMinutes: 15,
+					EnableElasticDisk:                  true,
+					GcpAttributes: &InstancePoolGcpAttributes{
+						Availability:         clusters.GcpAvailabilityPreemptible,
+						LocalSsdCount:        2,
+						BootDiskSize:         100,
+						GoogleServiceAccount: "pool@prj.iam.gserviceaccount.com",
+					},
+				},
+				Response: InstancePoolAndStats{
+					InstancePoolID: "abc",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/instance-pools/get?instance_pool_id=abc",
+				Response: InstancePoolAndStats{
+					InstancePoolID:                     "abc",
+					InstancePoolName:                   "Shared Pool",
+					MaxCapacity:                        100,
+					NodeTypeID:                         "n1-standard-4",
+					IdleInstanceAutoTerminationMinutes: 15,
+					EnableElasticDisk:                  true,
+					GcpAttributes: &InstancePoolGcpAttributes{
+						Availability:         clusters.GcpAvailabilityPreemptible,
+						LocalSsdCount:        2,
+						BootDiskSize:         100,
+						GoogleServiceAccount: "pool@prj.iam.gserviceaccount.com",
+					},
+				},
+			},
+		},
+		Resource: ResourceInstancePool(),
+		HCL: `
+		idle_instance_autotermination_minutes = 15
+		instance_pool_name = "Shared Pool"
+		max_capacity = 100
+		node_type_id = "n1-standard-4"
+		gcp_attributes {
+			gcp_availability = "PREEMPTIBLE_GCP"
+			local_ssd_count = 2
+			boot_disk_size = 100
+			google_service_account = "pool@prj.iam.gserviceaccount.com"
+		}`,
+		Create: true,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "abc", d.Id())
+	assert.Equal(t, "PREEMPTIBLE_GCP", d.Get("gcp_attributes.0.gcp_availability"))
+	assert.Equal(t, 2, d.Get("gcp_attributes.0.local_ssd_count"))
+	assert.Equal(t, 100, d.Get("gcp_attributes.0.boot_disk_size"))
+	assert.Equal(t, "pool@prj.iam.gserviceaccount.com", d.Get("gcp_attributes.0.google_service_account"))
+}
+
+func TestResourceInstancePoolCreate_GcpInvalidAvailability(t *testing.T) {
+	_, err := qa.ResourceFixture{
+		Resource: ResourceInstancePool(),
+		HCL: `
+		idle_instance_autotermination_minutes = 15
+		instance_pool_name = "Shared Pool"
+		node_type_id = "n1-standard-4"
+		gcp_attributes {
+			gcp_availability = "SPOT"
+		}`,
+		Create: true,
+	}.Apply(t)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "expected gcp_attributes.0.gcp_availability to be one of")
+}
+
 func TestResourceInstancePoolCreate_Error(t *testing.T) {
 	d, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
@@ -17,6 +17,7 @@ import (
 	"github.com/databrickslabs/terraform-provider-databricks/identity"
 	"github.com/databrickslabs/terraform-provider-databricks/mlflow"
 	"github.com/databrickslabs/terraform-provider-databricks/jobs"
+	"github.com/databrickslabs/terraform-provider-databricks/libraries"
 	"github.com/databrickslabs/terraform-provider-databricks/mws"
 	"github.com/databrickslabs/terraform-provider-databricks/permissions"
 	"github.com/databrickslabs/terraform-provider-databricks/pipelines"
@@ -34,15 +35,27 @@ func DatabricksProvider() *schema.Provider {
 			"databricks_aws_crossaccount_policy": access.DataAwsCrossAccountPolicy(),
 			"databricks_aws_assume_role_policy":  access.DataAwsAssumeRolePolicy(),
 			"databricks_aws_bucket_policy":       access.DataAwsBucketPolicy(),
+			"databricks_cluster_policies":        policies.DataSourceClusterPolicies(),
+			"databricks_clusters":                clusters.DataSourceClusters(),
 			"databricks_current_user":            identity.DataSourceCurrentUser(),
 			"databricks_dbfs_file":               storage.DataSourceDBFSFile(),
 			"databricks_dbfs_file_paths":         storage.DataSourceDBFSFilePaths(),
+			"databricks_effective_access":        identity.DataSourceEffectiveAccess(),
 			"databricks_group":                   identity.DataSourceGroup(),
+			"databricks_instance_pools":          pools.DataSourceInstancePools(),
+			"databricks_jobs":                    jobs.DataSourceJobs(),
+			"databricks_library_statuses":        libraries.DataSourceLibraryStatuses(),
 			"databricks_node_type":               clusters.DataSourceNodeType(),
 			"databricks_notebook":                workspace.DataSourceNotebook(),
 			"databricks_notebook_paths":          workspace.DataSourceNotebookPaths(),
+			"databricks_pipeline_events":         pipelines.DataSourcePipelineEvents(),
+			"databricks_pipeline_updates":        pipelines.DataSourcePipelineUpdates(),
+			"databricks_repos":                   workspace.DataSourceRepos(),
+			"databricks_secret_scopes":           access.DataSourceSecretScopes(),
+			"databricks_service_principals":      identity.DataSourceServicePrincipals(),
 			"databricks_spark_version":           clusters.DataSourceSparkVersion(),
 			"databricks_user":                    identity.DataSourceUser(),
+			"databricks_users":                   identity.DataSourceUsers(),
 			"databricks_zones":                   clusters.DataSourceClusterZones(),
 		},
 		ResourcesMap: map[string]*schema.Resource{ // must be in alphabetical order
@@ -58,12 +71,15 @@ func DatabricksProvider() *schema.Provider {
 			"databricks_group":                       identity.ResourceGroup(),
 			"databricks_group_instance_profile":      identity.ResourceGroupInstanceProfile(),
 			"databricks_group_member":                identity.ResourceGroupMember(),
+			"databricks_group_members":               identity.ResourceGroupMembers(),
 			"databricks_instance_pool":               pools.ResourceInstancePool(),
 			"databricks_instance_profile":            identity.ResourceInstanceProfile(),
 			"databricks_ip_access_list":              access.ResourceIPAccessList(),
 			"databricks_job":                         jobs.ResourceJob(),
+			"databricks_library":                     clusters.ResourceLibrary(),
  			"databricks_mlflow_experiment":           mlflow.ResourceMLFlowExperiment(),
 			"databricks_mlflow_model":                mlflow.ResourceMLFlowModel(),
+			"databricks_mlflow_model_version":        mlflow.ResourceMLFlowModelVersion(),
 			"databricks_mount":                       storage.ResourceDatabricksMount(),
 			"databricks_mws_customer_managed_keys":   mws.ResourceCustomerManagedKey(),
 			"databricks_mws_credentials":             mws.ResourceCredentials(),
@@ -81,7 +97,10 @@ func DatabricksProvider() *schema.Provider {
 			"databricks_secret":                      access.ResourceSecret(),
 			"databricks_secret_scope":                access.ResourceSecretScope(),
 			"databricks_secret_acl":                  access.ResourceSecretACL(),
+			"databricks_secrets":                     access.ResourceSecrets(),
 			"databricks_service_principal":           identity.ResourceServicePrincipal(),
+			"databricks_service_principal_secret":    identity.ResourceServicePrincipalSecret(),
+			"databricks_sql_alert":                   sqlanalytics.ResourceAlert(),
 			"databricks_sql_dashboard":               sqlanalytics.ResourceDashboard(),
 			"databricks_sql_endpoint":                sqlanalytics.ResourceSQLEndpoint(),
 			"databricks_sql_global_config":           sqlanalytics.ResourceSQLGlobalConfig(),
@@ -93,6 +112,8 @@ func DatabricksProvider() *schema.Provider {
 			"databricks_user":                        identity.ResourceUser(),
 			"databricks_user_instance_profile":       identity.ResourceUserInstanceProfile(),
 			"databricks_workspace_conf":              workspace.ResourceWorkspaceConf(),
+			"databricks_workspace_file":              workspace.ResourceWorkspaceFile(),
+			"databricks_workspace_sync":              workspace.ResourceWorkspaceSync(),
 		},
 		Schema: providerSchema(),
 	}
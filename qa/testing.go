@@ -87,6 +87,7 @@ type ResourceFixture struct {
 	Azure       bool
 	AzureSPN    bool
 	Gcp         bool
+	AccountID   string
 	Token       string
 	// new resource
 	New bool
@@ -168,6 +169,9 @@ func (f ResourceFixture) Apply(t *testing.T) (*schema.ResourceData, error) {
 	if f.Gcp {
 		client.GoogleServiceAccount = "sa@prj.iam.gserviceaccount.com"
 	}
+	if f.AccountID != "" {
+		client.AccountID = f.AccountID
+	}
 	if len(f.HCL) > 0 {
 		var out interface{}
 		// TODO: update to HCLv2 somehow, so that importer and this use the same stuff
@@ -216,9 +220,12 @@ func (f ResourceFixture) Apply(t *testing.T) (*schema.ResourceData, error) {
 	if execute != nil {
 		// this is a bit strange, but we'll fix it later
 		diags := execute(ctx, resourceData, client)
-		if diags != nil {
+		if diags.HasError() {
 			return resourceData, fmt.Errorf(diagsToString(diags))
 		}
+		for _, d := range diags {
+			log.Printf("[WARN] %s", d.Summary)
+		}
 	}
 	if resourceData.Id() == "" && !f.Removed {
 		return resourceData, fmt.Errorf("resource is not expected to be removed")
@@ -0,0 +1,95 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// Alert ...
+type Alert struct {
+	ID      string       `json:"id,omitempty"`
+	Name    string       `json:"name"`
+	QueryID string       `json:"query_id,omitempty"`
+	Query   *Query       `json:"query,omitempty"`
+	Options AlertOptions `json:"options"`
+
+	// Number of seconds after being triggered before the alert rearms itself
+	// and can be triggered again. If `null`, alert will never be triggered again.
+	Rearm *int `json:"rearm"`
+
+	// One of `unknown`, `ok` or `triggered`.
+	State string `json:"state,omitempty"`
+}
+
+// AlertOptions ...
+type AlertOptions struct {
+	Column        string        `json:"column"`
+	Op            string        `json:"op"`
+	Value         StringOrFloat `json:"value"`
+	Muted         bool          `json:"muted,omitempty"`
+	CustomSubject string        `json:"custom_subject,omitempty"`
+	CustomBody    string        `json:"custom_body,omitempty"`
+}
+
+// AlertSubscription links an alert with a notification destination or a user.
+type AlertSubscription struct {
+	ID            string       `json:"id,omitempty"`
+	AlertID       string       `json:"alert_id,omitempty"`
+	DestinationID string       `json:"destination_id,omitempty"`
+	Destination   *Destination `json:"destination,omitempty"`
+}
+
+// Destination is a notification destination, like email, Slack or webhook.
+type Destination struct {
+	ID   string `json:"id"`
+	Name string `json:"name,omitempty"`
+	Type string `json:"type,omitempty"`
+}
+
+// StringOrFloat is a type wrapper for a JSON value that can either be encoded
+// as a Javascript number or a Javascript string, like alert threshold.
+// Numbers keep their original text, so that 1.0 isn't turned into 1.
+type StringOrFloat string
+
+func (s StringOrFloat) String() string {
+	return string(s)
+}
+
+// isNumber returns true, if value is a valid JSON number
+func (s StringOrFloat) isNumber() bool {
+	if _, err := strconv.ParseFloat(string(s), 64); err != nil {
+		return false
+	}
+	var n json.Number
+	return json.Unmarshal([]byte(s), &n) == nil
+}
+
+func (s StringOrFloat) MarshalJSON() ([]byte, error) {
+	if s.isNumber() {
+		return []byte(s), nil
+	}
+	return json.Marshal(string(s))
+}
+
+func (s *StringOrFloat) UnmarshalJSON(b []byte) error {
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	var tmp interface{}
+	err := d.Decode(&tmp)
+	if err != nil {
+		return err
+	}
+
+	switch v := tmp.(type) {
+	case json.Number:
+		*s = StringOrFloat(v.String())
+	case string:
+		*s = StringOrFloat(v)
+	default:
+		return fmt.Errorf("json: expected to unmarshal a string or a number, got %T", v)
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package api
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAlertMarshalUnmarshal(t *testing.T) {
+	rearm := 300
+	a := Alert{
+		ID:      "id",
+		Name:    "name",
+		QueryID: "query",
+		Options: AlertOptions{
+			Column: "errors",
+			Op:     ">",
+			Value:  StringOrFloat("1.5"),
+		},
+		Rearm: &rearm,
+	}
+
+	out, err := json.Marshal(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Contains(t, string(out), `"value":1.5`)
+
+	var ap Alert
+	if err := json.Unmarshal(out, &ap); err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, a, ap)
+}
+
+func TestAlertValueString(t *testing.T) {
+	var o AlertOptions
+	err := json.Unmarshal([]byte(`{"column": "status", "op": "==", "value": "failed"}`), &o)
+	assert.NoError(t, err)
+	assert.Equal(t, "failed", o.Value.String())
+
+	out, err := json.Marshal(o)
+	assert.NoError(t, err)
+	assert.Contains(t, string(out), `"value":"failed"`)
+
+	err = json.Unmarshal([]byte(`{"value": true}`), &o)
+	assert.EqualError(t, err, "json: expected to unmarshal a string or a number, got bool")
+}
+
+func TestAlertValueKeepsNumberText(t *testing.T) {
+	out, err := json.Marshal(AlertOptions{Value: StringOrFloat("1.0")})
+	assert.NoError(t, err)
+	assert.Contains(t, string(out), `"value":1.0`)
+
+	var o AlertOptions
+	err = json.Unmarshal([]byte(`{"value": 1.0}`), &o)
+	assert.NoError(t, err)
+	assert.Equal(t, "1.0", o.Value.String())
+
+	// not a JSON number, even though it parses as float
+	out, err = json.Marshal(AlertOptions{Value: StringOrFloat("Inf")})
+	assert.NoError(t, err)
+	assert.Contains(t, string(out), `"value":"Inf"`)
+}
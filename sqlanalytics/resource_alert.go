@@ -0,0 +1,283 @@
+package sqlanalytics
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+	"github.com/databrickslabs/terraform-provider-databricks/sqlanalytics/api"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// AlertEntity defines the parameters that can be set in the resource.
+type AlertEntity struct {
+	Name         string        `json:"name"`
+	QueryID      string        `json:"query_id"`
+	Options      *AlertOptions `json:"options"`
+	Rearm        int           `json:"rearm,omitempty"`
+	Destinations []string      `json:"destinations,omitempty" tf:"slice_set"`
+	State        string        `json:"state,omitempty" tf:"computed"`
+}
+
+// AlertOptions ...
+type AlertOptions struct {
+	Column        string `json:"column"`
+	Op            string `json:"op"`
+	Value         string `json:"value"`
+	Muted         bool   `json:"muted,omitempty"`
+	CustomSubject string `json:"custom_subject,omitempty"`
+	CustomBody    string `json:"custom_body,omitempty"`
+}
+
+func (a *AlertEntity) toAPIObject(schema map[string]*schema.Schema, data *schema.ResourceData) (*api.Alert, error) {
+	// Extract from ResourceData.
+	if err := common.DataToStructPointer(data, schema, a); err != nil {
+		return nil, err
+	}
+
+	// Copy to API object.
+	var aa api.Alert
+	aa.ID = data.Id()
+	aa.Name = a.Name
+	aa.QueryID = a.QueryID
+	if a.Options != nil {
+		aa.Options = api.AlertOptions{
+			Column:        a.Options.Column,
+			Op:            a.Options.Op,
+			Value:         api.StringOrFloat(a.Options.Value),
+			Muted:         a.Options.Muted,
+			CustomSubject: a.Options.CustomSubject,
+			CustomBody:    a.Options.CustomBody,
+		}
+	}
+	if a.Rearm > 0 {
+		rearm := a.Rearm
+		aa.Rearm = &rearm
+	}
+
+	return &aa, nil
+}
+
+func (a *AlertEntity) fromAPIObject(aa *api.Alert, subscriptions []api.AlertSubscription,
+	schema map[string]*schema.Schema, data *schema.ResourceData) error {
+	// Copy from API object.
+	a.Name = aa.Name
+	a.QueryID = aa.QueryID
+	if aa.Query != nil {
+		a.QueryID = aa.Query.ID
+	}
+	a.Options = &AlertOptions{
+		Column:        aa.Options.Column,
+		Op:            aa.Options.Op,
+		Value:         aa.Options.Value.String(),
+		Muted:         aa.Options.Muted,
+		CustomSubject: aa.Options.CustomSubject,
+		CustomBody:    aa.Options.CustomBody,
+	}
+	a.Rearm = 0
+	if aa.Rearm != nil {
+		a.Rearm = *aa.Rearm
+	}
+	a.State = aa.State
+	a.Destinations = destinationIDs(subscriptions)
+
+	// Pass to ResourceData.
+	if err := common.StructToData(*a, schema, data); err != nil {
+		return err
+	}
+
+	// Overwrite fields, that would have been skipped by `common.StructToData`
+	// because of emptiness, in case they were removed on the server side.
+	data.Set("rearm", a.Rearm)
+	data.Set("destinations", a.Destinations)
+	return nil
+}
+
+// destinationIDs returns IDs of notification destinations, ignoring subscriptions of individual users,
+// as the owner of an alert is always subscribed to it.
+func destinationIDs(subscriptions []api.AlertSubscription) []string {
+	ids := []string{}
+	for _, s := range subscriptions {
+		if s.Destination != nil {
+			ids = append(ids, s.Destination.ID)
+		}
+	}
+	return ids
+}
+
+// NewAlertAPI ...
+func NewAlertAPI(ctx context.Context, m interface{}) AlertAPI {
+	return AlertAPI{m.(*common.DatabricksClient), ctx}
+}
+
+// AlertAPI ...
+type AlertAPI struct {
+	client  *common.DatabricksClient
+	context context.Context
+}
+
+// Create ...
+func (a AlertAPI) Create(aa *api.Alert) error {
+	return a.client.Post(a.context, "/preview/sql/alerts", aa, aa)
+}
+
+// Read ...
+func (a AlertAPI) Read(alertID string) (*api.Alert, error) {
+	var aa api.Alert
+	err := a.client.Get(a.context, fmt.Sprintf("/preview/sql/alerts/%s", alertID), nil, &aa)
+	if err != nil {
+		return nil, err
+	}
+
+	return &aa, nil
+}
+
+// Update ...
+func (a AlertAPI) Update(alertID string, aa *api.Alert) error {
+	return a.client.Put(a.context, fmt.Sprintf("/preview/sql/alerts/%s", alertID), aa)
+}
+
+// Delete ...
+func (a AlertAPI) Delete(alertID string) error {
+	return a.client.Delete(a.context, fmt.Sprintf("/preview/sql/alerts/%s", alertID), nil)
+}
+
+// Subscriptions returns all subscriptions of an alert
+func (a AlertAPI) Subscriptions(alertID string) (subscriptions []api.AlertSubscription, err error) {
+	err = a.client.Get(a.context, fmt.Sprintf("/preview/sql/alerts/%s/subscriptions", alertID), nil, &subscriptions)
+	return
+}
+
+// Subscribe notifies destination whenever alert is triggered
+func (a AlertAPI) Subscribe(alertID, destinationID string) error {
+	return a.client.Post(a.context, fmt.Sprintf("/preview/sql/alerts/%s/subscriptions", alertID),
+		api.AlertSubscription{
+			AlertID:       alertID,
+			DestinationID: destinationID,
+		}, nil)
+}
+
+// Unsubscribe removes subscription from an alert
+func (a AlertAPI) Unsubscribe(alertID, subscriptionID string) error {
+	return a.client.Delete(a.context, fmt.Sprintf("/preview/sql/alerts/%s/subscriptions/%s",
+		alertID, subscriptionID), nil)
+}
+
+// SyncDestinations subscribes missing destinations and unsubscribes the ones, that are not desired
+func (a AlertAPI) SyncDestinations(alertID string, destinations []string) error {
+	subscriptions, err := a.Subscriptions(alertID)
+	if err != nil {
+		return err
+	}
+	desired := map[string]bool{}
+	for _, id := range destinations {
+		desired[id] = true
+	}
+	for _, s := range subscriptions {
+		if s.Destination == nil {
+			continue
+		}
+		if desired[s.Destination.ID] {
+			delete(desired, s.Destination.ID)
+			continue
+		}
+		log.Printf("[INFO] Unsubscribing destination %s from alert %s", s.Destination.ID, alertID)
+		if err = a.Unsubscribe(alertID, s.ID); err != nil {
+			return err
+		}
+	}
+	for _, id := range destinations {
+		if !desired[id] {
+			continue
+		}
+		log.Printf("[INFO] Subscribing destination %s to alert %s", id, alertID)
+		if err = a.Subscribe(alertID, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sameNumber suppresses differences between equal numeric thresholds, like 1 and 1.0
+func sameNumber(k, old, new string, d *schema.ResourceData) bool {
+	o, err := strconv.ParseFloat(old, 64)
+	if err != nil {
+		return false
+	}
+	n, err := strconv.ParseFloat(new, 64)
+	if err != nil {
+		return false
+	}
+	return o == n
+}
+
+// ResourceAlert ...
+func ResourceAlert() *schema.Resource {
+	s := common.StructToSchema(
+		AlertEntity{},
+		func(m map[string]*schema.Schema) map[string]*schema.Schema {
+			options := m["options"].Elem.(*schema.Resource)
+			options.Schema["op"].ValidateFunc = validation.StringInSlice([]string{
+				">", ">=", "<", "<=", "==", "!=",
+			}, false)
+			options.Schema["value"].DiffSuppressFunc = sameNumber
+			m["rearm"].ValidateFunc = validation.IntAtLeast(0)
+			return m
+		})
+
+	return common.Resource{
+		Create: func(ctx context.Context, data *schema.ResourceData, c *common.DatabricksClient) error {
+			var a AlertEntity
+			aa, err := a.toAPIObject(s, data)
+			if err != nil {
+				return err
+			}
+
+			alertAPI := NewAlertAPI(ctx, c)
+			err = alertAPI.Create(aa)
+			if err != nil {
+				return err
+			}
+			data.SetId(aa.ID)
+			return alertAPI.SyncDestinations(aa.ID, a.Destinations)
+		},
+		Read: func(ctx context.Context, data *schema.ResourceData, c *common.DatabricksClient) error {
+			alertAPI := NewAlertAPI(ctx, c)
+			aa, err := alertAPI.Read(data.Id())
+			if err != nil {
+				return err
+			}
+			subscriptions, err := alertAPI.Subscriptions(data.Id())
+			if err != nil {
+				return err
+			}
+
+			var a AlertEntity
+			return a.fromAPIObject(aa, subscriptions, s, data)
+		},
+		Update: func(ctx context.Context, data *schema.ResourceData, c *common.DatabricksClient) error {
+			var a AlertEntity
+			aa, err := a.toAPIObject(s, data)
+			if err != nil {
+				return err
+			}
+
+			alertAPI := NewAlertAPI(ctx, c)
+			err = alertAPI.Update(data.Id(), aa)
+			if err != nil {
+				return err
+			}
+			if !data.HasChange("destinations") {
+				return nil
+			}
+			return alertAPI.SyncDestinations(data.Id(), a.Destinations)
+		},
+		Delete: func(ctx context.Context, data *schema.ResourceData, c *common.DatabricksClient) error {
+			return NewAlertAPI(ctx, c).Delete(data.Id())
+		},
+		Schema: s,
+	}.ToResource()
+}
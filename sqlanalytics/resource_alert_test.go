@@ -0,0 +1,301 @@
+package sqlanalytics
+
+import (
+	"testing"
+
+	"github.com/databrickslabs/terraform-provider-databricks/qa"
+	"github.com/databrickslabs/terraform-provider-databricks/sqlanalytics/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func alertRearm(seconds int) *int {
+	return &seconds
+}
+
+func TestAlertCreate(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/preview/sql/alerts",
+				ExpectedRequest: api.Alert{
+					Name:    "Too many errors",
+					QueryID: "foo",
+					Options: api.AlertOptions{
+						Column:        "errors",
+						Op:            ">",
+						Value:         api.StringOrFloat("10"),
+						CustomSubject: "{{ALERT_NAME}} fired",
+					},
+					Rearm: alertRearm(3600),
+				},
+				Response: api.Alert{
+					ID: "xyz",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/sql/alerts/xyz/subscriptions",
+				Response: []api.AlertSubscription{
+					{
+						ID:      "1",
+						AlertID: "xyz",
+					},
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/preview/sql/alerts/xyz/subscriptions",
+				ExpectedRequest: api.AlertSubscription{
+					AlertID:       "xyz",
+					DestinationID: "slack",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/sql/alerts/xyz",
+				Response: api.Alert{
+					ID:   "xyz",
+					Name: "Too many errors",
+					Query: &api.Query{
+						ID: "foo",
+					},
+					Options: api.AlertOptions{
+						Column:        "errors",
+						Op:            ">",
+						Value:         api.StringOrFloat("10"),
+						CustomSubject: "{{ALERT_NAME}} fired",
+					},
+					Rearm: alertRearm(3600),
+					State: "unknown",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/sql/alerts/xyz/subscriptions",
+				Response: []api.AlertSubscription{
+					{
+						ID:      "1",
+						AlertID: "xyz",
+					},
+					{
+						ID:      "2",
+						AlertID: "xyz",
+						Destination: &api.Destination{
+							ID:   "slack",
+							Type: "slack",
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceAlert(),
+		Create:   true,
+		HCL: `
+		name = "Too many errors"
+		query_id = "foo"
+		rearm = 3600
+		options {
+			column = "errors"
+			op = ">"
+			value = "10"
+			custom_subject = "{{ALERT_NAME}} fired"
+		}
+		destinations = ["slack"]
+		`,
+	}.Apply(t)
+
+	assert.NoError(t, err, err)
+	assert.Equal(t, "xyz", d.Id(), "Resource ID should not be empty")
+	assert.Equal(t, "foo", d.Get("query_id"))
+	assert.Equal(t, "10", d.Get("options.0.value"))
+	assert.Equal(t, "unknown", d.Get("state"))
+	assert.Equal(t, 1, d.Get("destinations.#"))
+}
+
+func TestAlertRead(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/sql/alerts/xyz",
+				Response: api.Alert{
+					ID:   "xyz",
+					Name: "Too many errors",
+					Query: &api.Query{
+						ID: "foo",
+					},
+					Options: api.AlertOptions{
+						Column: "errors",
+						Op:     ">=",
+						Value:  api.StringOrFloat("0.5"),
+					},
+					State: "triggered",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/sql/alerts/xyz/subscriptions",
+				Response: []api.AlertSubscription{},
+			},
+		},
+		Resource: ResourceAlert(),
+		Read:     true,
+		New:      true,
+		ID:       "xyz",
+	}.Apply(t)
+
+	assert.NoError(t, err, err)
+	assert.Equal(t, "xyz", d.Id(), "Resource ID should not be empty")
+	assert.Equal(t, ">=", d.Get("options.0.op"))
+	assert.Equal(t, "0.5", d.Get("options.0.value"))
+	assert.Equal(t, 0, d.Get("rearm"))
+	assert.Equal(t, "triggered", d.Get("state"))
+}
+
+func TestAlertUpdate(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PUT",
+				Resource: "/api/2.0/preview/sql/alerts/xyz",
+				ExpectedRequest: api.Alert{
+					ID:      "xyz",
+					Name:    "Failed runs",
+					QueryID: "foo",
+					Options: api.AlertOptions{
+						Column: "status",
+						Op:     "==",
+						Value:  api.StringOrFloat("failed"),
+						Muted:  true,
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/sql/alerts/xyz/subscriptions",
+				Response: []api.AlertSubscription{
+					{
+						ID:      "2",
+						AlertID: "xyz",
+						Destination: &api.Destination{
+							ID: "slack",
+						},
+					},
+				},
+			},
+			{
+				Method:   "DELETE",
+				Resource: "/api/2.0/preview/sql/alerts/xyz/subscriptions/2",
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/preview/sql/alerts/xyz/subscriptions",
+				ExpectedRequest: api.AlertSubscription{
+					AlertID:       "xyz",
+					DestinationID: "email",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/sql/alerts/xyz",
+				Response: api.Alert{
+					ID:      "xyz",
+					Name:    "Failed runs",
+					QueryID: "foo",
+					Options: api.AlertOptions{
+						Column: "status",
+						Op:     "==",
+						Value:  api.StringOrFloat("failed"),
+						Muted:  true,
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/sql/alerts/xyz/subscriptions",
+				Response: []api.AlertSubscription{
+					{
+						ID:      "3",
+						AlertID: "xyz",
+						Destination: &api.Destination{
+							ID: "email",
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceAlert(),
+		Update:   true,
+		ID:       "xyz",
+		InstanceState: map[string]string{
+			"name":             "Too many errors",
+			"query_id":         "foo",
+			"options.#":        "1",
+			"options.0.column": "errors",
+			"options.0.op":     ">",
+			"options.0.value":  "10",
+			"rearm":            "3600",
+		},
+		HCL: `
+		name = "Failed runs"
+		query_id = "foo"
+		options {
+			column = "status"
+			op = "=="
+			value = "failed"
+			muted = true
+		}
+		destinations = ["email"]
+		`,
+	}.Apply(t)
+
+	assert.NoError(t, err, err)
+	assert.Equal(t, "Failed runs", d.Get("name"))
+	assert.Equal(t, 0, d.Get("rearm"))
+}
+
+func TestAlertCreate_InvalidOperator(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceAlert(),
+		Create:   true,
+		HCL: `
+		name = "Too many errors"
+		query_id = "foo"
+		options {
+			column = "errors"
+			op = "greater"
+			value = "10"
+		}
+		`,
+	}.ExpectError(t, "invalid config supplied. [options.#.op] expected options.0.op "+
+		"to be one of [> >= < <= == !=], got greater")
+}
+
+func TestAlertDelete(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "DELETE",
+				Resource: "/api/2.0/preview/sql/alerts/xyz",
+			},
+		},
+		Resource: ResourceAlert(),
+		Delete:   true,
+		ID:       "xyz",
+	}.Apply(t)
+
+	assert.NoError(t, err, err)
+	assert.Equal(t, "xyz", d.Id(), "Resource ID should not be empty")
+}
+
+func TestResourceAlert_CornerCases(t *testing.T) {
+	qa.ResourceCornerCases(t, ResourceAlert())
+}
+
+func TestAlertValueSameNumber(t *testing.T) {
+	assert.True(t, sameNumber("options.0.value", "1", "1.0", nil))
+	assert.False(t, sameNumber("options.0.value", "1", "1.5", nil))
+	assert.False(t, sameNumber("options.0.value", "failed", "1", nil))
+	assert.False(t, sameNumber("options.0.value", "1", "failed", nil))
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/databrickslabs/terraform-provider-databricks/common"
@@ -17,8 +18,13 @@ import (
 var (
 	ClusterSizes   = []string{"2X-Small", "X-Small", "Small", "Medium", "Large", "X-Large", "2X-Large", "3X-Large", "4X-Large"}
 	MaxNumClusters = 30
+	Channels       = []string{"CHANNEL_NAME_CURRENT", "CHANNEL_NAME_PREVIEW"}
+	WarehouseTypes = []string{"CLASSIC", "PRO"}
 )
 
+// DefaultProvisionTimeout is the amount of time SQL endpoint is waited for to start or stop
+const DefaultProvisionTimeout = 20 * time.Minute
+
 // SQLEndpoint ...
 type SQLEndpoint struct {
 	ID                      string      `json:"id,omitempty" tf:"computed"`
@@ -36,6 +42,8 @@ type SQLEndpoint struct {
 	OdbcParams              *OdbcParams `json:"odbc_params,omitempty" tf:"computed"`
 	Tags                    *Tags       `json:"tags,omitempty" tf:"suppress_diff"`
 	SpotInstancePolicy      string      `json:"spot_instance_policy,omitempty"`
+	Channel                 *Channel    `json:"channel,omitempty" tf:"suppress_diff"`
+	WarehouseType           string      `json:"warehouse_type,omitempty" tf:"computed"`
 
 	// The data source ID is not part of the endpoint API response.
 	// We manually resolve it by retrieving the list of data sources
@@ -55,6 +63,11 @@ type OdbcParams struct {
 	Host string `json:"host,omitempty"`
 }
 
+// Channel is the release channel of Databricks SQL runtime
+type Channel struct {
+	Name string `json:"name,omitempty"`
+}
+
 // Tags ...
 type Tags struct {
 	CustomTags []Tag `json:"custom_tags"`
@@ -70,7 +83,6 @@ type Tag struct {
 //
 // Note: this object returns more fields than contained in this struct,
 // but we only list the ones that are in use here.
-//
 type DataSource struct {
 	ID         string `json:"id"`
 	EndpointID string `json:"endpoint_id"`
@@ -104,7 +116,7 @@ func (a SQLEndpointsAPI) Start(endpointID string, timeout time.Duration) error {
 	if err != nil {
 		return err
 	}
-	return a.waitForRunning(endpointID, timeout)
+	return a.waitForState(endpointID, timeout, "RUNNING")
 }
 
 // Stop ...
@@ -125,7 +137,7 @@ func (a SQLEndpointsAPI) Create(se *SQLEndpoint, timeout time.Duration) error {
 	if err != nil {
 		return err
 	}
-	return a.waitForRunning(se.ID, timeout)
+	return a.waitForState(se.ID, timeout, "RUNNING", "STOPPED")
 }
 
 // ResolveDataSourceID ...
@@ -150,20 +162,25 @@ func (a SQLEndpointsAPI) ResolveDataSourceID(endpointID string) (dataSourceID st
 	return
 }
 
-func (a SQLEndpointsAPI) waitForRunning(id string, timeout time.Duration) error {
+// waitForState polls endpoint until it reaches one of desired states
+func (a SQLEndpointsAPI) waitForState(id string, timeout time.Duration, desired ...string) error {
 	return resource.RetryContext(a.context, timeout, func() *resource.RetryError {
 		endpoint, err := a.Get(id)
 		if err != nil {
 			return resource.NonRetryableError(err)
 		}
+		for _, state := range desired {
+			if endpoint.State == state {
+				return nil
+			}
+		}
 		switch endpoint.State {
-		case "RUNNING":
-			return nil
-		case "DELETED":
+		case "DELETING", "DELETED":
 			return resource.NonRetryableError(
-				fmt.Errorf("endpoint got deleted during creation"))
+				fmt.Errorf("endpoint %s got deleted", id))
 		default:
-			msg := fmt.Errorf("endpoint %s is %s", id, endpoint.State)
+			msg := fmt.Errorf("endpoint %s is %s, but has to be %s",
+				id, endpoint.State, strings.Join(desired, " or "))
 			log.Printf("[INFO] %s", msg.Error())
 			return resource.RetryableError(msg)
 		}
@@ -171,8 +188,12 @@ func (a SQLEndpointsAPI) waitForRunning(id string, timeout time.Duration) error
 }
 
 // Edit ...
-func (a SQLEndpointsAPI) Edit(se SQLEndpoint) error {
-	return a.client.Post(a.context, fmt.Sprintf("/sql/endpoints/%s/edit", se.ID), se, nil)
+func (a SQLEndpointsAPI) Edit(se SQLEndpoint, timeout time.Duration) error {
+	err := a.client.Post(a.context, fmt.Sprintf("/sql/endpoints/%s/edit", se.ID), se, nil)
+	if err != nil {
+		return err
+	}
+	return a.waitForState(se.ID, timeout, "RUNNING", "STOPPED")
 }
 
 // Delete ...
@@ -195,9 +216,26 @@ func ResourceSQLEndpoint() *schema.Resource {
 		m["num_clusters"].Default = 1
 		m["spot_instance_policy"].Default = "COST_OPTIMIZED"
 		m["enable_photon"].Default = true
+		m["channel"].Elem.(*schema.Resource).Schema["name"].Default = "CHANNEL_NAME_CURRENT"
+		m["channel"].Elem.(*schema.Resource).Schema["name"].ValidateDiagFunc = validation.ToDiagFunc(
+			validation.StringInSlice(Channels, false))
+		m["warehouse_type"].ValidateDiagFunc = validation.ToDiagFunc(
+			validation.StringInSlice(WarehouseTypes, false))
 		return m
 	})
 	return common.Resource{
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, c interface{}) error {
+			if !d.NewValueKnown("min_num_clusters") || !d.NewValueKnown("max_num_clusters") {
+				return nil
+			}
+			minNumClusters := d.Get("min_num_clusters").(int)
+			maxNumClusters := d.Get("max_num_clusters").(int)
+			if minNumClusters > maxNumClusters {
+				return fmt.Errorf("min_num_clusters (%d) must be less than or equal to max_num_clusters (%d)",
+					minNumClusters, maxNumClusters)
+			}
+			return nil
+		},
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			var se SQLEndpoint
 			if err := common.DataToStructPointer(d, s, &se); err != nil {
@@ -226,11 +264,15 @@ func ResourceSQLEndpoint() *schema.Resource {
 			if err := common.DataToStructPointer(d, s, &se); err != nil {
 				return err
 			}
-			return NewSQLEndpointsAPI(ctx, c).Edit(se)
+			return NewSQLEndpointsAPI(ctx, c).Edit(se, d.Timeout(schema.TimeoutUpdate))
 		},
 		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			return NewSQLEndpointsAPI(ctx, c).Delete(d.Id())
 		},
 		Schema: s,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultProvisionTimeout),
+			Update: schema.DefaultTimeout(DefaultProvisionTimeout),
+		},
 	}.ToResource()
 }
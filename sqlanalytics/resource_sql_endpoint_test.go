@@ -41,7 +41,7 @@ func TestPreviewAccSQLEndpoints(t *testing.T) {
 	}()
 
 	se.Name = "renamed-" + se.Name
-	err = endpoitsAPI.Edit(se)
+	err = endpoitsAPI.Edit(se, 20*time.Minute)
 	require.NoError(t, err)
 }
 
@@ -264,14 +264,6 @@ func TestSQLEnpointAPI(t *testing.T) {
 				State: "DELETED",
 			},
 		},
-		{
-			Method:   "GET",
-			Resource: "/api/2.0/sql/endpoints/cantwait",
-			Status:   500,
-			Response: common.APIError{
-				Message: "does not compute",
-			},
-		},
 		{
 			Method:   "POST",
 			Resource: "/api/2.0/sql/endpoints/stopping/stop",
@@ -286,12 +278,139 @@ func TestSQLEnpointAPI(t *testing.T) {
 		assert.EqualError(t, err, "nope")
 
 		err = a.Start("deleting", 5*time.Minute)
-		assert.EqualError(t, err, "endpoint got deleted during creation")
-
-		err = a.waitForRunning("cantwait", 5*time.Minute)
-		assert.EqualError(t, err, "does not compute")
+		assert.EqualError(t, err, "endpoint deleting got deleted")
 
 		err = a.Stop("stopping")
 		require.NoError(t, err)
 	})
 }
+
+func TestResourceSQLEndpointCreate_ChannelAndType(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/sql/endpoints",
+				ExpectedRequest: SQLEndpoint{
+					Name:               "foo",
+					ClusterSize:        "Small",
+					MaxNumClusters:     2,
+					AutoStopMinutes:    120,
+					MinNumClusters:     1,
+					NumClusters:        1,
+					EnablePhoton:       true,
+					SpotInstancePolicy: "COST_OPTIMIZED",
+					Channel: &Channel{
+						Name: "CHANNEL_NAME_PREVIEW",
+					},
+					WarehouseType: "PRO",
+				},
+				Response: SQLEndpoint{
+					ID: "abc",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/sql/endpoints/abc",
+				Response: SQLEndpoint{
+					ID:    "abc",
+					State: "STARTING",
+				},
+			},
+			{
+				Method:       "GET",
+				Resource:     "/api/2.0/sql/endpoints/abc",
+				ReuseRequest: true,
+				Response: SQLEndpoint{
+					Name:           "foo",
+					ClusterSize:    "Small",
+					ID:             "abc",
+					State:          "RUNNING",
+					MaxNumClusters: 2,
+					Channel: &Channel{
+						Name: "CHANNEL_NAME_PREVIEW",
+					},
+					WarehouseType: "PRO",
+				},
+			},
+			dataSourceListHTTPFixture,
+		},
+		Resource: ResourceSQLEndpoint(),
+		Create:   true,
+		HCL: `
+		name = "foo"
+		cluster_size = "Small"
+		max_num_clusters = 2
+		warehouse_type = "PRO"
+		channel {
+			name = "CHANNEL_NAME_PREVIEW"
+		}
+		`,
+	}.Apply(t)
+	require.NoError(t, err, err)
+	assert.Equal(t, "PRO", d.Get("warehouse_type"))
+	assert.Equal(t, "CHANNEL_NAME_PREVIEW", d.Get("channel.0.name"))
+}
+
+func TestResourceSQLEndpointCreate_MinGreaterThanMax(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceSQLEndpoint(),
+		Create:   true,
+		HCL: `
+		name = "foo"
+		cluster_size = "Small"
+		min_num_clusters = 3
+		max_num_clusters = 2
+		`,
+	}.ExpectError(t, "min_num_clusters (3) must be less than or equal to max_num_clusters (2)")
+}
+
+func TestResourceSQLEndpointCreate_InvalidSize(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceSQLEndpoint(),
+		Create:   true,
+		HCL: `
+		name = "foo"
+		cluster_size = "Huge"
+		`,
+	}.ExpectError(t, "invalid config supplied. [cluster_size] expected cluster_size "+
+		"to be one of [2X-Small X-Small Small Medium Large X-Large 2X-Large 3X-Large 4X-Large], got Huge")
+}
+
+func TestResourceSQLEndpointUpdate_WaitsForStopped(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/sql/endpoints/abc/edit",
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/sql/endpoints/abc",
+				Response: SQLEndpoint{
+					ID:    "abc",
+					State: "STOPPING",
+				},
+			},
+			{
+				Method:       "GET",
+				Resource:     "/api/2.0/sql/endpoints/abc",
+				ReuseRequest: true,
+				Response: SQLEndpoint{
+					Name:        "foo",
+					ClusterSize: "Small",
+					ID:          "abc",
+					State:       "STOPPED",
+				},
+			},
+			dataSourceListHTTPFixture,
+		},
+		Resource: ResourceSQLEndpoint(),
+		ID:       "abc",
+		Update:   true,
+		HCL: `
+		name = "foo"
+		cluster_size = "Small"
+		`,
+	}.ApplyNoError(t)
+}
@@ -163,7 +163,11 @@ func GetOrCreateMountingClusterWithGcpServiceAccount(
 	clustersAPI clusters.ClustersAPI, serviceAccount string) (i clusters.ClusterInfo, err error) {
 	clusterName := fmt.Sprintf("terraform-mount-gcs-%x", md5.Sum([]byte(serviceAccount)))
 	cluster := getCommonClusterObject(clustersAPI, clusterName)
-	cluster.GcpAttributes = &clusters.GcpAttributes{GoogleServiceAccount: serviceAccount}
+	// mounting cluster must not be preempted while the mount command runs
+	cluster.GcpAttributes = &clusters.GcpAttributes{
+		GoogleServiceAccount: serviceAccount,
+		Availability:         clusters.GcpAvailabilityOnDemand,
+	}
 	return clustersAPI.GetOrCreateRunningCluster(clusterName, cluster)
 }
 
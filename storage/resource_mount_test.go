@@ -1398,6 +1398,7 @@ func TestResourceGcsMountGenericCreate_WithServiceAccount(t *testing.T) {
 					NodeTypeID: "Standard_F4s",
 					GcpAttributes: &clusters.GcpAttributes{
 						GoogleServiceAccount: "acc@acc-dbx.iam.gserviceaccount.com",
+						Availability:         clusters.GcpAvailabilityOnDemand,
 					},
 					AutoterminationMinutes: 10,
 					SparkConf: map[string]string{"spark.databricks.cluster.profile": "singleNode",
@@ -19,12 +19,14 @@ func DataSourceNotebook() *schema.Resource {
 		},
 		"format": {
 			Type:     schema.TypeString,
-			Required: true,
+			Optional: true,
+			Default:  string(Source),
 			ForceNew: true,
 			ValidateFunc: validation.StringInSlice([]string{
 				string(DBC),
 				string(Source),
 				string(HTML),
+				string(Jupyter),
 			}, false),
 		},
 		"content": {
@@ -42,3 +42,36 @@ func TestDataSourceNotebook(t *testing.T) {
 	assert.Equal(t, "/a/b/c", d.Id())
 	assert.Equal(t, "SGVsbG8gd29ybGQK", d.Get("content"))
 }
+
+func TestDataSourceNotebook_Jupyter(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/workspace/get-status?path=%2Fa%2Fb%2Fc",
+				Response: ObjectStatus{
+					ObjectID:   987,
+					Language:   "PYTHON",
+					ObjectType: "NOTEBOOK",
+					Path:       "/a/b/c",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/workspace/export?format=JUPYTER&path=%2Fa%2Fb%2Fc",
+				Response: NotebookContent{
+					Content: "e30K",
+				},
+			},
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceNotebook(),
+		ID:          ".",
+		HCL: `
+		path = "/a/b/c"
+		format = "JUPYTER"`,
+	}.Apply(t)
+	require.NoError(t, err)
+	assert.Equal(t, "e30K", d.Get("content"))
+}
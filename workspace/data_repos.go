@@ -0,0 +1,73 @@
+package workspace
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// RepoSummary is the subset of repo attributes exposed by listing data source
+type RepoSummary struct {
+	ID          string `json:"id,omitempty"`
+	Url         string `json:"url,omitempty"`
+	GitProvider string `json:"git_provider,omitempty"`
+	Path        string `json:"path,omitempty"`
+	Branch      string `json:"branch,omitempty"`
+	CommitHash  string `json:"commit_hash,omitempty"`
+}
+
+// DataSourceRepos returns repos, which paths start with the given prefix
+func DataSourceRepos() *schema.Resource {
+	type reposFilter struct {
+		PathPrefix string        `json:"path_prefix,omitempty"`
+		IDs        []string      `json:"ids,omitempty" tf:"computed,slice_set"`
+		Repos      []RepoSummary `json:"repos,omitempty" tf:"computed"`
+	}
+	s := common.StructToSchema(reposFilter{}, func(
+		s map[string]*schema.Schema) map[string]*schema.Schema {
+		return s
+	})
+	return &schema.Resource{
+		Schema: s,
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			var this reposFilter
+			err := common.DataToStructPointer(d, s, &this)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			list, err := NewReposAPI(ctx, m).ListAll()
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			sort.Slice(list, func(i, j int) bool {
+				return list[i].Path < list[j].Path
+			})
+			this.IDs = []string{}
+			this.Repos = []RepoSummary{}
+			for _, repo := range list {
+				if !strings.HasPrefix(repo.Path, this.PathPrefix) {
+					continue
+				}
+				this.IDs = append(this.IDs, repo.RepoID())
+				this.Repos = append(this.Repos, RepoSummary{
+					ID:          repo.RepoID(),
+					Url:         repo.Url,
+					GitProvider: repo.Provider,
+					Path:        repo.Path,
+					Branch:      repo.Branch,
+					CommitHash:  repo.HeadCommitID,
+				})
+			}
+			d.SetId("_")
+			err = common.StructToData(this, s, d)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,75 @@
+package workspace
+
+import (
+	"testing"
+
+	"github.com/databrickslabs/terraform-provider-databricks/qa"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataSourceRepos(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/repos?",
+				Response: ReposListResponse{
+					Repos: []ReposInformation{
+						{
+							ID:           2,
+							Url:          "https://github.com/user/b.git",
+							Provider:     "gitHub",
+							Path:         "/Repos/production/b",
+							Branch:       "main",
+							HeadCommitID: "abc",
+						},
+						{
+							ID:       3,
+							Url:      "https://github.com/user/c.git",
+							Provider: "gitHub",
+							Path:     "/Repos/user@domain/c",
+						},
+						{
+							ID:       1,
+							Url:      "https://gitlab.com/user/a.git",
+							Provider: "gitLab",
+							Path:     "/Repos/production/a",
+							Branch:   "releases",
+						},
+					},
+				},
+			},
+		},
+		Resource:    DataSourceRepos(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+		HCL:         `path_prefix = "/Repos/production/"`,
+	}.Apply(t)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []interface{}{"1", "2"}, d.Get("ids").(*schema.Set).List())
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{
+			"id":           "1",
+			"url":          "https://gitlab.com/user/a.git",
+			"git_provider": "gitLab",
+			"path":         "/Repos/production/a",
+			"branch":       "releases",
+			"commit_hash":  "",
+		},
+		map[string]interface{}{
+			"id":           "2",
+			"url":          "https://github.com/user/b.git",
+			"git_provider": "gitHub",
+			"path":         "/Repos/production/b",
+			"branch":       "main",
+			"commit_hash":  "abc",
+		},
+	}, d.Get("repos"))
+}
+
+func TestDataSourceRepos_Error(t *testing.T) {
+	qa.ResourceCornerCases(t, DataSourceRepos(), qa.CornerCaseID("_"))
+}
@@ -0,0 +1,99 @@
+package workspace
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// gitRef returns full name of the ref, that is checked out in repo
+func gitRef(branch, tag string) string {
+	if tag != "" {
+		return "refs/tags/" + tag
+	}
+	if branch != "" {
+		return "refs/heads/" + branch
+	}
+	return "HEAD"
+}
+
+// remoteHeadCommit resolves commit of the ref in remote repository through Git smart HTTP protocol,
+// just like `git ls-remote` does. It works only for repositories, that don't require authentication,
+// as no credentials are sent. Given transport is wrapped into a client private to this call,
+// so that proxy settings of the provider are respected.
+func remoteHeadCommit(ctx context.Context, transport http.RoundTripper, repoURL, ref string) (string, error) {
+	u := strings.TrimSuffix(repoURL, "/") + "/info/refs?service=git-upload-pack"
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return "", err
+	}
+	client := &http.Client{
+		Transport: transport,
+		Timeout:   30 * time.Second,
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("cannot list refs of %s: %s", repoURL, resp.Status)
+	}
+	refs, err := parseRefAdvertisement(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	// annotated tags are followed by peeled entry with the commit they point to
+	if commit, ok := refs[ref+"^{}"]; ok {
+		return commit, nil
+	}
+	if commit, ok := refs[ref]; ok {
+		return commit, nil
+	}
+	return "", fmt.Errorf("%s is not found in %s", ref, repoURL)
+}
+
+// parseRefAdvertisement reads pkt-line formatted response of git-upload-pack service into commits by ref names
+func parseRefAdvertisement(r io.Reader) (map[string]string, error) {
+	refs := map[string]string{}
+	reader := bufio.NewReader(r)
+	header := make([]byte, 4)
+	for {
+		if _, err := io.ReadFull(reader, header); err == io.EOF {
+			return refs, nil
+		} else if err != nil {
+			return nil, err
+		}
+		length, err := strconv.ParseUint(string(header), 16, 16)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pkt-line length: %s", header)
+		}
+		if length == 0 {
+			// flush packet separates service announcement from refs
+			continue
+		}
+		if length < 4 {
+			return nil, fmt.Errorf("invalid pkt-line length: %s", header)
+		}
+		payload := make([]byte, length-4)
+		if _, err = io.ReadFull(reader, payload); err != nil {
+			return nil, err
+		}
+		line := strings.TrimSuffix(string(payload), "\n")
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		// first ref is followed by capabilities
+		line = strings.SplitN(line, "\x00", 2)[0]
+		parts := strings.SplitN(line, " ", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		refs[parts[1]] = parts[0]
+	}
+}
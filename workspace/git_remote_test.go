@@ -0,0 +1,83 @@
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func pktLines(lines ...string) string {
+	var sb strings.Builder
+	for _, line := range lines {
+		if line == "" {
+			sb.WriteString("0000")
+			continue
+		}
+		sb.WriteString(fmt.Sprintf("%04x%s", len(line)+4, line))
+	}
+	return sb.String()
+}
+
+func gitServer(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/user/test.git/info/refs" {
+			rw.WriteHeader(404)
+			return
+		}
+		assert.Equal(t, "git-upload-pack", req.URL.Query().Get("service"))
+		_, err := rw.Write([]byte(pktLines(
+			"# service=git-upload-pack\n",
+			"",
+			"aaa HEAD\x00multi_ack symref=HEAD:refs/heads/main\n",
+			"aaa refs/heads/main\n",
+			"bbb refs/heads/releases\n",
+			"ccc refs/tags/v0.1\n",
+			"ddd refs/tags/v0.1^{}\n",
+			"",
+		)))
+		assert.NoError(t, err)
+	}))
+}
+
+func TestGitRef(t *testing.T) {
+	assert.Equal(t, "refs/tags/v1", gitRef("main", "v1"))
+	assert.Equal(t, "refs/heads/main", gitRef("main", ""))
+	assert.Equal(t, "HEAD", gitRef("", ""))
+}
+
+func TestRemoteHeadCommit(t *testing.T) {
+	server := gitServer(t)
+	defer server.Close()
+	ctx := context.Background()
+	repoURL := server.URL + "/user/test.git"
+
+	for ref, commit := range map[string]string{
+		"HEAD":                "aaa",
+		"refs/heads/releases": "bbb",
+		"refs/tags/v0.1":      "ddd",
+	} {
+		head, err := remoteHeadCommit(ctx, http.DefaultTransport, repoURL, ref)
+		require.NoError(t, err)
+		assert.Equal(t, commit, head, ref)
+	}
+
+	_, err := remoteHeadCommit(ctx, http.DefaultTransport, repoURL, "refs/heads/missing")
+	assert.EqualError(t, err, fmt.Sprintf("refs/heads/missing is not found in %s", repoURL))
+
+	_, err = remoteHeadCommit(ctx, http.DefaultTransport, server.URL+"/user/private.git", "HEAD")
+	assert.EqualError(t, err, fmt.Sprintf("cannot list refs of %s/user/private.git: 404 Not Found", server.URL))
+}
+
+func TestParseRefAdvertisement_Invalid(t *testing.T) {
+	_, err := parseRefAdvertisement(strings.NewReader("zzzz"))
+	assert.EqualError(t, err, "invalid pkt-line length: zzzz")
+
+	_, err = parseRefAdvertisement(strings.NewReader("0010abc"))
+	assert.Error(t, err)
+}
@@ -1,8 +1,12 @@
 package workspace
 
 import (
+	"bytes"
 	"context"
+	"crypto/md5"
 	"encoding/base64"
+	"encoding/json"
+	"fmt"
 	"path/filepath"
 	"strings"
 	"sync"
@@ -28,6 +32,7 @@ const (
 	HTML    ExportFormat = "HTML"
 	Jupyter ExportFormat = "JUPYTER"
 	DBC     ExportFormat = "DBC"
+	Auto    ExportFormat = "AUTO"
 
 	Scala  Language = "SCALA"
 	Python Language = "PYTHON"
@@ -37,6 +42,7 @@ const (
 	Notebook      ObjectType = "NOTEBOOK"
 	Directory     ObjectType = "DIRECTORY"
 	LibraryObject ObjectType = "LIBRARY"
+	File          ObjectType = "FILE"
 )
 
 var extMap = map[string]string{
@@ -46,6 +52,12 @@ var extMap = map[string]string{
 	".r":     "R",
 }
 
+// formatExtMap contains extensions of notebooks, that are not imported as SOURCE
+var formatExtMap = map[string]ExportFormat{
+	".ipynb": Jupyter,
+	".dbc":   DBC,
+}
+
 // ObjectStatus contains information when doing a get request or list request on the workspace api
 type ObjectStatus struct {
 	ObjectID   int64      `json:"object_id,omitempty" tf:"computed"`
@@ -189,6 +201,86 @@ func (a NotebooksAPI) Delete(path string, recursive bool) error {
 	}, nil)
 }
 
+// attributeGetter is implemented by both schema.ResourceData and schema.ResourceDiff
+type attributeGetter interface {
+	Get(key string) interface{}
+	GetOk(key string) (interface{}, bool)
+}
+
+// notebookFormat returns explicitly configured import format or derives it from the source extension
+func notebookFormat(d attributeGetter) ExportFormat {
+	if format, ok := d.GetOk("format"); ok {
+		return ExportFormat(format.(string))
+	}
+	source := d.Get("source").(string)
+	if format, ok := formatExtMap[strings.ToLower(filepath.Ext(source))]; ok {
+		return format
+	}
+	return Source
+}
+
+// normalizeNotebook removes differences in notebook content, that don't survive the import:
+// line endings of source notebooks, as well as outputs and formatting of Jupyter notebooks
+func normalizeNotebook(content []byte, format ExportFormat) []byte {
+	switch format {
+	case Source:
+		return bytes.ReplaceAll(content, []byte("\r\n"), []byte("\n"))
+	case Jupyter:
+		var nb map[string]interface{}
+		if err := json.Unmarshal(content, &nb); err != nil {
+			return content
+		}
+		cells, _ := nb["cells"].([]interface{})
+		for _, c := range cells {
+			if cell, ok := c.(map[string]interface{}); ok {
+				delete(cell, "outputs")
+				delete(cell, "execution_count")
+			}
+		}
+		// keys are sorted and whitespace is removed
+		normalized, err := json.Marshal(nb)
+		if err != nil {
+			return content
+		}
+		return normalized
+	}
+	return content
+}
+
+// readNotebookContent reads content and sets format-aware checksum
+func readNotebookContent(d *schema.ResourceData) ([]byte, error) {
+	content, err := ReadContent(d)
+	if err != nil {
+		return nil, err
+	}
+	d.Set("md5", fmt.Sprintf("%x", md5.Sum(normalizeNotebook(content, notebookFormat(d)))))
+	return content, nil
+}
+
+// notebookImportRequest prepares import of content in SOURCE, JUPYTER or DBC format.
+// Only SOURCE format requires language, as other formats include it. DBC archives
+// cannot overwrite existing objects, so they are deleted and imported again.
+func notebookImportRequest(d *schema.ResourceData, content []byte, path string) (ImportRequest, error) {
+	format := notebookFormat(d)
+	lang := d.Get("language").(string)
+	if lang == "" && format == Source {
+		lang = extMap[strings.ToLower(filepath.Ext(d.Get("source").(string)))]
+		if lang == "" {
+			return ImportRequest{}, fmt.Errorf("language is required to import %s in %s format", path, format)
+		}
+	}
+	if format != Source {
+		lang = ""
+	}
+	return ImportRequest{
+		Content:   base64.StdEncoding.EncodeToString(content),
+		Language:  lang,
+		Format:    string(format),
+		Overwrite: format != DBC,
+		Path:      path,
+	}, nil
+}
+
 // ResourceNotebook manages notebooks
 func ResourceNotebook() *schema.Resource {
 	s := FileContentSchema(map[string]*schema.Schema{
@@ -202,6 +294,10 @@ func ResourceNotebook() *schema.Resource {
 				string(SQL),
 			}, false),
 			DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+				if new == "" && notebookFormat(d) != Source {
+					// language is part of Jupyter and DBC content
+					return true
+				}
 				source := d.Get("source").(string)
 				if source == "" {
 					return false
@@ -209,6 +305,15 @@ func ResourceNotebook() *schema.Resource {
 				return old == extMap[strings.ToLower(filepath.Ext(source))]
 			},
 		},
+		"format": {
+			Type:     schema.TypeString,
+			Optional: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(Source),
+				string(Jupyter),
+				string(DBC),
+			}, false),
+		},
 		"url": {
 			Type:     schema.TypeString,
 			Computed: true,
@@ -224,17 +329,49 @@ func ResourceNotebook() *schema.Resource {
 			Computed: true,
 		},
 	})
-	s["content_base64"].RequiredWith = []string{"language"}
+	s["md5"].DiffSuppressFunc = func(k, old, new string, d *schema.ResourceData) bool {
+		if _, err := readNotebookContent(d); err != nil {
+			return false
+		}
+		return old == d.Get("md5")
+	}
 	return common.Resource{
 		Schema:        s,
 		SchemaVersion: 1,
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, c interface{}) error {
+			old, _ := d.GetChange("md5")
+			if notebookFormat(d) != DBC || old == "" ||
+				!d.NewValueKnown("content_base64") || !d.NewValueKnown("source") {
+				// Update deletes and imports archives, if content is not yet known
+				return nil
+			}
+			var content []byte
+			var err error
+			if b64 := d.Get("content_base64").(string); b64 != "" {
+				content, err = base64.StdEncoding.DecodeString(b64)
+			} else {
+				content, err = readFileContent(d.Get("source"))
+			}
+			if err != nil {
+				return err
+			}
+			if old == fmt.Sprintf("%x", md5.Sum(content)) {
+				return nil
+			}
+			// DBC archives cannot be imported over existing notebooks
+			return d.ForceNew("md5")
+		},
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
-			content, err := ReadContent(d)
+			content, err := readNotebookContent(d)
 			if err != nil {
 				return err
 			}
 			notebooksAPI := NewNotebooksAPI(ctx, c)
 			path := d.Get("path").(string)
+			r, err := notebookImportRequest(d, content, path)
+			if err != nil {
+				return err
+			}
 			parent := filepath.ToSlash(filepath.Dir(path))
 			if parent != "/" {
 				err = notebooksAPI.Mkdirs(parent)
@@ -243,18 +380,7 @@ func ResourceNotebook() *schema.Resource {
 					return err
 				}
 			}
-			lang := d.Get("language").(string)
-			if lang == "" {
-				// TODO: check what happens with empty source
-				lang = extMap[strings.ToLower(filepath.Ext(d.Get("source").(string)))]
-			}
-			if err = notebooksAPI.Create(ImportRequest{
-				Content:   base64.StdEncoding.EncodeToString(content),
-				Language:  lang,
-				Format:    "SOURCE",
-				Overwrite: true,
-				Path:      path,
-			}); err != nil {
+			if err = notebooksAPI.Create(r); err != nil {
 				return err
 			}
 			d.SetId(path)
@@ -271,17 +397,21 @@ func ResourceNotebook() *schema.Resource {
 		},
 		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			notebooksAPI := NewNotebooksAPI(ctx, c)
-			content, err := ReadContent(d)
+			content, err := readNotebookContent(d)
 			if err != nil {
 				return err
 			}
-			return notebooksAPI.Create(ImportRequest{
-				Content:   base64.StdEncoding.EncodeToString(content),
-				Language:  d.Get("language").(string),
-				Format:    "SOURCE",
-				Overwrite: true,
-				Path:      d.Id(),
-			})
+			r, err := notebookImportRequest(d, content, d.Id())
+			if err != nil {
+				return err
+			}
+			if !r.Overwrite {
+				err = notebooksAPI.Delete(d.Id(), true)
+				if err != nil && !common.IsMissing(err) {
+					return err
+				}
+			}
+			return notebooksAPI.Create(r)
 		},
 		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			return NewNotebooksAPI(ctx, c).Delete(d.Id(), true)
@@ -1,6 +1,10 @@
 package workspace
 
 import (
+	"crypto/md5"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
 	"net/http"
 	"testing"
 
@@ -9,6 +13,7 @@ import (
 	"github.com/databrickslabs/terraform-provider-databricks/qa"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestResourceNotebookRead(t *testing.T) {
@@ -270,3 +275,205 @@ func TestResourceNotebookUpdate(t *testing.T) {
 		Update:      true,
 	}.ApplyNoError(t)
 }
+
+func TestResourceNotebookCreateJupyter(t *testing.T) {
+	content, err := ioutil.ReadFile("acceptance/testdata/tf-test-jupyter.ipynb")
+	require.NoError(t, err)
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/workspace/mkdirs",
+				ExpectedRequest: map[string]string{
+					"path": "/foo",
+				},
+			},
+			{
+				Method:   http.MethodPost,
+				Resource: "/api/2.0/workspace/import",
+				ExpectedRequest: ImportRequest{
+					Content:   base64.StdEncoding.EncodeToString(content),
+					Path:      "/foo/Notebook",
+					Overwrite: true,
+					Format:    "JUPYTER",
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/workspace/get-status?path=%2Ffoo%2FNotebook",
+				Response: ObjectStatus{
+					ObjectID:   4567,
+					ObjectType: "NOTEBOOK",
+					Path:       "/foo/Notebook",
+					Language:   "PYTHON",
+				},
+			},
+		},
+		Resource: ResourceNotebook(),
+		State: map[string]interface{}{
+			"source": "acceptance/testdata/tf-test-jupyter.ipynb",
+			"path":   "/foo/Notebook",
+		},
+		Create: true,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "/foo/Notebook", d.Id())
+	assert.Equal(t, fmt.Sprintf("%x", md5.Sum(normalizeNotebook(content, Jupyter))), d.Get("md5"))
+}
+
+func TestResourceNotebookCreateDBC(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPost,
+				Resource: "/api/2.0/workspace/import",
+				ExpectedRequest: ImportRequest{
+					Content: "YWJjCg==",
+					Path:    "/Archive",
+					Format:  "DBC",
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/workspace/get-status?path=%2FArchive",
+				Response: ObjectStatus{
+					ObjectID:   4567,
+					ObjectType: "NOTEBOOK",
+					Path:       "/Archive",
+					Language:   "SCALA",
+				},
+			},
+		},
+		Resource: ResourceNotebook(),
+		State: map[string]interface{}{
+			"content_base64": "YWJjCg==",
+			"format":         "DBC",
+			"path":           "/Archive",
+		},
+		Create: true,
+	}.ApplyNoError(t)
+}
+
+func TestResourceNotebookUpdateDBC_RequiresNew(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPost,
+				Resource: "/api/2.0/workspace/delete",
+				ExpectedRequest: NotebookDeleteRequest{
+					Path:      "/Archive",
+					Recursive: true,
+				},
+			},
+			{
+				Method:   http.MethodPost,
+				Resource: "/api/2.0/workspace/import",
+				ExpectedRequest: ImportRequest{
+					Content: "eHl6Cg==",
+					Path:    "/Archive",
+					Format:  "DBC",
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/workspace/get-status?path=%2FArchive",
+				Response: ObjectStatus{
+					ObjectID:   4567,
+					ObjectType: "NOTEBOOK",
+					Path:       "/Archive",
+					Language:   "SCALA",
+				},
+			},
+		},
+		Resource: ResourceNotebook(),
+		ID:       "/Archive",
+		InstanceState: map[string]string{
+			"content_base64": "YWJjCg==",
+			"format":         "DBC",
+			"path":           "/Archive",
+			"md5":            "0bee89b07a248e27c83fc3d5951213c1",
+		},
+		HCL: `content_base64 = "eHl6Cg=="
+		format = "DBC"
+		path = "/Archive"`,
+		Update:      true,
+		RequiresNew: true,
+	}.ApplyNoError(t)
+}
+
+func TestResourceNotebookUpdateDBC_DeletesBeforeImport(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPost,
+				Resource: "/api/2.0/workspace/delete",
+				ExpectedRequest: NotebookDeleteRequest{
+					Path:      "/Archive",
+					Recursive: true,
+				},
+			},
+			{
+				Method:   http.MethodPost,
+				Resource: "/api/2.0/workspace/import",
+				ExpectedRequest: ImportRequest{
+					Content: "YWJjCg==",
+					Path:    "/Archive",
+					Format:  "DBC",
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/workspace/get-status?path=%2FArchive",
+				Response: ObjectStatus{
+					ObjectID:   4567,
+					ObjectType: "NOTEBOOK",
+					Path:       "/Archive",
+					Language:   "SCALA",
+				},
+			},
+		},
+		Resource: ResourceNotebook(),
+		ID:       "/Archive",
+		InstanceState: map[string]string{
+			"content_base64": "YWJjCg==",
+			"format":         "DBC",
+			"path":           "/Archive",
+			"object_type":    "NOTEBOOK",
+			"md5":            "0bee89b07a248e27c83fc3d5951213c1",
+		},
+		HCL: `content_base64 = "YWJjCg=="
+		format = "DBC"
+		path = "/Archive"
+		object_type = "DIRECTORY"`,
+		Update: true,
+	}.ApplyNoError(t)
+}
+
+func TestResourceNotebookCreate_NoLanguage(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceNotebook(),
+		State: map[string]interface{}{
+			"content_base64": "YWJjCg==",
+			"path":           "/Notebook",
+		},
+		Create: true,
+	}.ExpectError(t, "language is required to import /Notebook in SOURCE format")
+}
+
+func TestNormalizeNotebook(t *testing.T) {
+	assert.Equal(t, "a\nb\n", string(normalizeNotebook([]byte("a\r\nb\r\n"), Source)))
+	assert.Equal(t, "a\r\nb", string(normalizeNotebook([]byte("a\r\nb"), DBC)))
+	assert.Equal(t, "{not json", string(normalizeNotebook([]byte("{not json"), Jupyter)))
+
+	executed := `{
+		"nbformat": 4,
+		"cells": [{
+			"cell_type": "code",
+			"execution_count": 3,
+			"source": ["print(1)"],
+			"outputs": [{"output_type": "stream", "text": ["1"]}]
+		}]
+	}`
+	clean := `{"cells":[{"cell_type":"code","source":["print(1)"]}],"nbformat":4}`
+	assert.Equal(t, clean, string(normalizeNotebook([]byte(executed), Jupyter)))
+}
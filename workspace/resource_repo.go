@@ -3,6 +3,8 @@ package workspace
 import (
 	"context"
 	"fmt"
+	"log"
+	"net/http"
 	"net/url"
 	"path"
 	"strings"
@@ -23,14 +25,20 @@ func NewReposAPI(ctx context.Context, m interface{}) ReposAPI {
 	return ReposAPI{m.(*common.DatabricksClient), ctx}
 }
 
+// SparseCheckout limits checked out directories of repository to the given patterns
+type SparseCheckout struct {
+	Patterns []string `json:"patterns"`
+}
+
 // ReposInformation provides information about given repository
 type ReposInformation struct {
-	ID           int64  `json:"id"`
-	Url          string `json:"url" tf:"force_new"`
-	Provider     string `json:"provider,omitempty" tf:"computed,alias:git_provider,force_new"`
-	Path         string `json:"path,omitempty" tf:"computed,force_new"` // TODO: remove force_new after the Update API will support changing the path
-	Branch       string `json:"branch,omitempty" tf:"computed"`
-	HeadCommitID string `json:"head_commit_id,omitempty" tf:"computed,alias:commit_hash"`
+	ID             int64           `json:"id"`
+	Url            string          `json:"url" tf:"force_new"`
+	Provider       string          `json:"provider,omitempty" tf:"computed,alias:git_provider,force_new"`
+	Path           string          `json:"path,omitempty" tf:"computed,force_new"` // TODO: remove force_new after the Update API will support changing the path
+	Branch         string          `json:"branch,omitempty" tf:"computed"`
+	HeadCommitID   string          `json:"head_commit_id,omitempty" tf:"computed,alias:commit_hash"`
+	SparseCheckout *SparseCheckout `json:"sparse_checkout,omitempty"`
 }
 
 // ID returns job id as string
@@ -39,9 +47,10 @@ func (r ReposInformation) RepoID() string {
 }
 
 type createRequest struct {
-	Url      string `json:"url"`
-	Provider string `json:"provider"`
-	Path     string `json:"path,omitempty"`
+	Url            string          `json:"url"`
+	Provider       string          `json:"provider"`
+	Path           string          `json:"path,omitempty"`
+	SparseCheckout *SparseCheckout `json:"sparse_checkout,omitempty"`
 }
 
 func (a ReposAPI) Create(r createRequest) (ReposInformation, error) {
@@ -74,18 +83,20 @@ func (a ReposAPI) Delete(id string) error {
 	return a.client.Delete(a.context, fmt.Sprintf("/repos/%s", id), nil)
 }
 
-func (a ReposAPI) Update(id string, r map[string]string) error {
-	if len(r) == 0 {
-		return nil
-	}
-	// TODO: update may change ONE OF (url AND provider (optional)), (path), or (branch OR tag).
+func (a ReposAPI) Update(id string, r map[string]interface{}) error {
+	// TODO: update may change ONE OF (url AND provider (optional)), (path), (sparse_checkout) or (branch OR tag).
 	// for URL/provider force re-create as there are limits on what could be done for changing URL/provider
-	if path, ok := r["path"]; ok {
-		err := a.client.Patch(a.context, fmt.Sprintf("/repos/%s", id), map[string]string{"path": path})
-		if err != nil {
-			return err
+	for _, separate := range []string{"path", "sparse_checkout"} {
+		if v, ok := r[separate]; ok {
+			err := a.client.Patch(a.context, fmt.Sprintf("/repos/%s", id), map[string]interface{}{separate: v})
+			if err != nil {
+				return err
+			}
+			delete(r, separate)
 		}
-		delete(r, "path")
+	}
+	if len(r) == 0 {
+		return nil
 	}
 	return a.client.Patch(a.context, fmt.Sprintf("/repos/%s", id), r)
 }
@@ -157,6 +168,11 @@ func ResourceRepo() *schema.Resource {
 			ConflictsWith: []string{"branch"},
 			ValidateFunc:  validation.StringIsNotWhiteSpace,
 		}
+		s["track_latest"] = &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		}
 
 		delete(s, "id")
 		return s
@@ -165,9 +181,48 @@ func ResourceRepo() *schema.Resource {
 	return common.Resource{
 		Schema:        s,
 		SchemaVersion: 1,
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, c interface{}) error {
+			if oldURL, _ := d.GetChange("url"); oldURL.(string) == "" {
+				// repo is not yet created
+				return nil
+			}
+			// sparse checkout could only be enabled or disabled by cloning repository again
+			if old, new := d.GetChange("sparse_checkout.#"); old.(int) != new.(int) {
+				if err := d.ForceNew("sparse_checkout"); err != nil {
+					return err
+				}
+			}
+			if !d.Get("track_latest").(bool) {
+				return nil
+			}
+			ref := gitRef(d.Get("branch").(string), d.Get("tag").(string))
+			transport := http.DefaultTransport
+			if client, ok := c.(*common.DatabricksClient); ok {
+				transport = client.Transport()
+			}
+			head, err := remoteHeadCommit(ctx, transport, d.Get("url").(string), ref)
+			if err != nil {
+				return fmt.Errorf("cannot resolve %s of %s, track_latest works only for repositories, "+
+					"that can be read without authentication: %w", ref, d.Get("url"), err)
+			}
+			if head == d.Get("commit_hash").(string) {
+				return nil
+			}
+			log.Printf("[INFO] %s of %s has moved to %s", ref, d.Get("url"), head)
+			return d.SetNew("commit_hash", head)
+		},
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			reposAPI := NewReposAPI(ctx, c)
-			req := createRequest{Path: d.Get("path").(string), Provider: d.Get("git_provider").(string), Url: d.Get("url").(string)}
+			var repo ReposInformation
+			if err := common.DataToStructPointer(d, s, &repo); err != nil {
+				return err
+			}
+			req := createRequest{
+				Path:           d.Get("path").(string),
+				Provider:       d.Get("git_provider").(string),
+				Url:            d.Get("url").(string),
+				SparseCheckout: repo.SparseCheckout,
+			}
 			resp, err := reposAPI.Create(req)
 			if err != nil {
 				return err
@@ -175,7 +230,7 @@ func ResourceRepo() *schema.Resource {
 			d.SetId(resp.RepoID())
 			branch := d.Get("branch").(string)
 			tag := d.Get("tag").(string)
-			updateReq := map[string]string{}
+			updateReq := map[string]interface{}{}
 			if tag != "" {
 				updateReq["tag"] = tag
 			} else if branch != "" && branch != resp.Branch {
@@ -193,16 +248,30 @@ func ResourceRepo() *schema.Resource {
 		},
 		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			reposAPI := NewReposAPI(ctx, c)
-			req := map[string]string{}
+			req := map[string]interface{}{}
 			// Not working yet, wait until API is ready
 			// if d.HasChange("path") {
 			// 	req["path"] = d.Get("path").(string)
 			// }
+			if d.HasChange("sparse_checkout") {
+				var repo ReposInformation
+				if err := common.DataToStructPointer(d, s, &repo); err != nil {
+					return err
+				}
+				req["sparse_checkout"] = repo.SparseCheckout
+			}
 			if d.HasChange("tag") {
 				req["tag"] = d.Get("tag").(string)
 				d.Set("branch", "")
 			} else if d.HasChange("branch") {
 				req["branch"] = d.Get("branch").(string)
+			} else if d.HasChange("commit_hash") && d.Get("track_latest").(bool) {
+				// checking out the same branch or tag again pulls the latest commit
+				if tag := d.Get("tag").(string); tag != "" {
+					req["tag"] = tag
+				} else {
+					req["branch"] = d.Get("branch").(string)
+				}
 			}
 			return reposAPI.Update(d.Id(), req)
 		},
@@ -489,3 +489,235 @@ func TestReposListWithPrefix(t *testing.T) {
 	assert.Equal(t, len(reposList), 1)
 	assert.Equal(t, resp.Branch, reposList[0].Branch)
 }
+
+func TestResourceRepoCreateWithSparseCheckout(t *testing.T) {
+	resp := ReposInformation{
+		ID:           121232342,
+		Url:          "https://github.com/user/test.git",
+		Provider:     "gitHub",
+		Branch:       "main",
+		Path:         "/Repos/user@domain/test",
+		HeadCommitID: "1124323423abc23424",
+		SparseCheckout: &SparseCheckout{
+			Patterns: []string{"jobs", "libs/common"},
+		},
+	}
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/repos",
+				ExpectedRequest: createRequest{
+					Url:      "https://github.com/user/test.git",
+					Provider: "gitHub",
+					SparseCheckout: &SparseCheckout{
+						Patterns: []string{"jobs", "libs/common"},
+					},
+				},
+				Response: resp,
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/repos/121232342",
+				Response: resp,
+			},
+		},
+		Resource: ResourceRepo(),
+		HCL: `
+		url = "https://github.com/user/test.git"
+		sparse_checkout {
+			patterns = ["jobs", "libs/common"]
+		}`,
+		Create: true,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, resp.RepoID(), d.Id())
+	assert.Equal(t, "libs/common", d.Get("sparse_checkout.0.patterns.1"))
+}
+
+func TestResourceReposUpdateSparseCheckout(t *testing.T) {
+	resp := ReposInformation{
+		ID:           121232342,
+		Url:          "https://github.com/user/test.git",
+		Provider:     "gitHub",
+		Path:         "/Repos/user@domain/test",
+		Branch:       "main",
+		HeadCommitID: "1124323423abc23424",
+		SparseCheckout: &SparseCheckout{
+			Patterns: []string{"jobs", "pipelines"},
+		},
+	}
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.0/repos/121232342",
+				ExpectedRequest: map[string]interface{}{
+					"sparse_checkout": map[string]interface{}{
+						"patterns": []string{"jobs", "pipelines"},
+					},
+				},
+				Response: resp,
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/repos/121232342",
+				Response: resp,
+			},
+		},
+		Resource: ResourceRepo(),
+		InstanceState: map[string]string{
+			"url":                          "https://github.com/user/test.git",
+			"git_provider":                 "gitHub",
+			"path":                         "/Repos/user@domain/test",
+			"branch":                       "main",
+			"commit_hash":                  "1124323423abc23424",
+			"sparse_checkout.#":            "1",
+			"sparse_checkout.0.patterns.#": "1",
+			"sparse_checkout.0.patterns.0": "jobs",
+		},
+		HCL: `
+		url = "https://github.com/user/test.git"
+		git_provider = "gitHub"
+		path = "/Repos/user@domain/test"
+		sparse_checkout {
+			patterns = ["jobs", "pipelines"]
+		}`,
+		ID:     "121232342",
+		Update: true,
+	}.ApplyNoError(t)
+}
+
+func TestResourceReposUpdateDisableSparseCheckout(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceRepo(),
+		InstanceState: map[string]string{
+			"url":                          "https://github.com/user/test.git",
+			"git_provider":                 "gitHub",
+			"path":                         "/Repos/user@domain/test",
+			"branch":                       "main",
+			"sparse_checkout.#":            "1",
+			"sparse_checkout.0.patterns.#": "1",
+			"sparse_checkout.0.patterns.0": "jobs",
+		},
+		HCL: `
+		url = "https://github.com/user/test.git"
+		git_provider = "gitHub"
+		path = "/Repos/user@domain/test"`,
+		ID:     "121232342",
+		Update: true,
+	}.ExpectError(t, "changes require new: sparse_checkout.#")
+}
+
+func TestResourceReposUpdateTrackLatest(t *testing.T) {
+	server := gitServer(t)
+	defer server.Close()
+	repoURL := server.URL + "/user/test.git"
+	resp := ReposInformation{
+		ID:           121232342,
+		Url:          repoURL,
+		Provider:     "gitHub",
+		Path:         "/Repos/user@domain/test",
+		Branch:       "releases",
+		HeadCommitID: "bbb",
+	}
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:          "PATCH",
+				Resource:        "/api/2.0/repos/121232342",
+				ExpectedRequest: map[string]interface{}{"branch": "releases"},
+				Response:        resp,
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/repos/121232342",
+				Response: resp,
+			},
+		},
+		Resource: ResourceRepo(),
+		InstanceState: map[string]string{
+			"url":          repoURL,
+			"git_provider": "gitHub",
+			"path":         "/Repos/user@domain/test",
+			"branch":       "releases",
+			"commit_hash":  "old",
+			"track_latest": "true",
+		},
+		HCL: fmt.Sprintf(`
+		url = "%s"
+		git_provider = "gitHub"
+		path = "/Repos/user@domain/test"
+		branch = "releases"
+		track_latest = true`, repoURL),
+		ID:     "121232342",
+		Update: true,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "bbb", d.Get("commit_hash"))
+}
+
+func TestResourceReposUpdateTrackLatest_UpToDate(t *testing.T) {
+	server := gitServer(t)
+	defer server.Close()
+	repoURL := server.URL + "/user/test.git"
+	resp := ReposInformation{
+		ID:           121232342,
+		Url:          repoURL,
+		Provider:     "gitHub",
+		Path:         "/Repos/user@domain/test",
+		HeadCommitID: "ddd",
+	}
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/repos/121232342",
+				Response: resp,
+			},
+		},
+		Resource: ResourceRepo(),
+		InstanceState: map[string]string{
+			"url":          repoURL,
+			"git_provider": "gitHub",
+			"path":         "/Repos/user@domain/test",
+			"tag":          "v0.1",
+			"commit_hash":  "ddd",
+			"track_latest": "false",
+		},
+		HCL: fmt.Sprintf(`
+		url = "%s"
+		git_provider = "gitHub"
+		path = "/Repos/user@domain/test"
+		tag = "v0.1"
+		track_latest = true`, repoURL),
+		ID:     "121232342",
+		Update: true,
+	}.ApplyNoError(t)
+}
+
+func TestResourceReposUpdateTrackLatest_PrivateRepo(t *testing.T) {
+	server := gitServer(t)
+	defer server.Close()
+	repoURL := server.URL + "/user/private.git"
+	qa.ResourceFixture{
+		Resource: ResourceRepo(),
+		InstanceState: map[string]string{
+			"url":          repoURL,
+			"git_provider": "gitHub",
+			"path":         "/Repos/user@domain/private",
+			"branch":       "main",
+			"commit_hash":  "aaa",
+			"track_latest": "true",
+		},
+		HCL: fmt.Sprintf(`
+		url = "%s"
+		git_provider = "gitHub"
+		path = "/Repos/user@domain/private"
+		track_latest = true`, repoURL),
+		ID:     "121232342",
+		Update: true,
+	}.ExpectError(t, fmt.Sprintf("cannot resolve refs/heads/main of %s, track_latest works only "+
+		"for repositories, that can be read without authentication: cannot list refs of %s: 404 Not Found",
+		repoURL, repoURL))
+}
@@ -0,0 +1,89 @@
+package workspace
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"fmt"
+	"path/filepath"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceWorkspaceFile manages files, that are not notebooks, in workspace tree
+func ResourceWorkspaceFile() *schema.Resource {
+	s := FileContentSchema(map[string]*schema.Schema{
+		"url": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"object_id": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
+		},
+	})
+	importFile := func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient, path string) error {
+		content, err := ReadContent(d)
+		if err != nil {
+			return err
+		}
+		return NewNotebooksAPI(ctx, c).Create(ImportRequest{
+			Content:   base64.StdEncoding.EncodeToString(content),
+			Path:      path,
+			Format:    string(Auto),
+			Overwrite: true,
+		})
+	}
+	return common.Resource{
+		Schema: s,
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			path := d.Get("path").(string)
+			parent := filepath.ToSlash(filepath.Dir(path))
+			if parent != "/" {
+				if err := NewNotebooksAPI(ctx, c).Mkdirs(parent); err != nil {
+					return err
+				}
+			}
+			if err := importFile(ctx, d, c, path); err != nil {
+				return err
+			}
+			d.SetId(path)
+			return nil
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			notebooksAPI := NewNotebooksAPI(ctx, c)
+			objectStatus, err := notebooksAPI.Read(d.Id())
+			if err != nil {
+				return err
+			}
+			if objectStatus.ObjectType != File {
+				return fmt.Errorf("different object type, %s, on this path other than a file", objectStatus.ObjectType)
+			}
+			if md5Hash := d.Get("md5").(string); md5Hash == "" || md5Hash == "different" {
+				// checksum of imported file is taken from its remote content,
+				// so that the next apply doesn't upload the same content again
+				b64, err := notebooksAPI.Export(d.Id(), Auto)
+				if err != nil {
+					return err
+				}
+				content, err := base64.StdEncoding.DecodeString(b64)
+				if err != nil {
+					return err
+				}
+				d.Set("md5", fmt.Sprintf("%x", md5.Sum(content)))
+			}
+			d.Set("url", c.FormatURL("#workspace", d.Id()))
+			d.Set("path", objectStatus.Path)
+			return d.Set("object_id", objectStatus.ObjectID)
+		},
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			return importFile(ctx, d, c, d.Id())
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			return NewNotebooksAPI(ctx, c).Delete(d.Id(), false)
+		},
+	}.ToResource()
+}
@@ -0,0 +1,213 @@
+package workspace
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+	"github.com/databrickslabs/terraform-provider-databricks/qa"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceWorkspaceFileCreate(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPost,
+				Resource: "/api/2.0/workspace/mkdirs",
+				ExpectedRequest: map[string]string{
+					"path": "/Shared/config",
+				},
+			},
+			{
+				Method:   http.MethodPost,
+				Resource: "/api/2.0/workspace/import",
+				ExpectedRequest: ImportRequest{
+					Content:   "a2V5OiB2YWx1ZQo=",
+					Path:      "/Shared/config/app.yml",
+					Format:    "AUTO",
+					Overwrite: true,
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/workspace/get-status?path=%2FShared%2Fconfig%2Fapp.yml",
+				Response: ObjectStatus{
+					ObjectID:   4567,
+					ObjectType: File,
+					Path:       "/Shared/config/app.yml",
+				},
+			},
+		},
+		Resource: ResourceWorkspaceFile(),
+		State: map[string]interface{}{
+			"content_base64": "a2V5OiB2YWx1ZQo=",
+			"path":           "/Shared/config/app.yml",
+		},
+		Create: true,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "/Shared/config/app.yml", d.Id())
+	assert.Equal(t, 4567, d.Get("object_id"))
+	assert.Equal(t, md5Hex("key: value\n"), d.Get("md5"))
+}
+
+func TestResourceWorkspaceFileCreate_Source(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPost,
+				Resource: "/api/2.0/workspace/import",
+				ExpectedRequest: ImportRequest{
+					Content: "LS0gRGF0YWJyaWNrcyBub3RlYm9vayBzb3VyY2UKU0VMRUNUIDEwKjIwC" +
+						"gotLSBDT01NQU5EIC0tLS0tLS0tLS0KClNFTEVDVCAyMCoxMDAKCi0tIE" +
+						"NPTU1BTkQgLS0tLS0tLS0tLQoKCg==",
+					Path:      "/query.sql",
+					Format:    "AUTO",
+					Overwrite: true,
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/workspace/get-status?path=%2Fquery.sql",
+				Response: ObjectStatus{
+					ObjectID:   4567,
+					ObjectType: File,
+					Path:       "/query.sql",
+				},
+			},
+		},
+		Resource: ResourceWorkspaceFile(),
+		State: map[string]interface{}{
+			"source": "acceptance/testdata/tf-test-sql.sql",
+			"path":   "/query.sql",
+		},
+		Create: true,
+	}.ApplyNoError(t)
+}
+
+func TestResourceWorkspaceFileRead(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/workspace/get-status?path=%2Flib%2Futils.py",
+				Response: ObjectStatus{
+					ObjectID:   123,
+					ObjectType: File,
+					Path:       "/lib/utils.py",
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/workspace/export?format=AUTO&path=%2Flib%2Futils.py",
+				Response: NotebookContent{
+					Content: "cHJpbnQoMSk=",
+				},
+			},
+		},
+		Resource: ResourceWorkspaceFile(),
+		Read:     true,
+		New:      true,
+		ID:       "/lib/utils.py",
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, md5Hex("print(1)"), d.Get("md5"))
+	assert.Equal(t, "/lib/utils.py", d.Get("path"))
+	assert.Equal(t, 123, d.Get("object_id"))
+	assert.True(t, strings.HasSuffix(d.Get("url").(string), "/#workspace/lib/utils.py"))
+}
+
+func TestResourceWorkspaceFileRead_NotFile(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/workspace/get-status?path=%2Flib%2Futils",
+				Response: ObjectStatus{
+					ObjectID:   123,
+					ObjectType: Notebook,
+					Path:       "/lib/utils",
+					Language:   Python,
+				},
+			},
+		},
+		Resource: ResourceWorkspaceFile(),
+		Read:     true,
+		New:      true,
+		ID:       "/lib/utils",
+	}.ExpectError(t, "different object type, NOTEBOOK, on this path other than a file")
+}
+
+func TestResourceWorkspaceFileRead_NotFound(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/workspace/get-status?path=%2Flib%2Futils.py",
+				Status:   404,
+				Response: common.APIErrorBody{
+					ErrorCode: "RESOURCE_DOES_NOT_EXIST",
+					Message:   "Path (/lib/utils.py) doesn't exist.",
+				},
+			},
+		},
+		Resource: ResourceWorkspaceFile(),
+		Read:     true,
+		Removed:  true,
+		ID:       "/lib/utils.py",
+	}.ApplyNoError(t)
+}
+
+func TestResourceWorkspaceFileUpdate(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPost,
+				Resource: "/api/2.0/workspace/import",
+				ExpectedRequest: ImportRequest{
+					Content:   "YWJjCg==",
+					Path:      "/lib/utils.py",
+					Format:    "AUTO",
+					Overwrite: true,
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/workspace/get-status?path=%2Flib%2Futils.py",
+				Response: ObjectStatus{
+					ObjectID:   123,
+					ObjectType: File,
+					Path:       "/lib/utils.py",
+				},
+			},
+		},
+		Resource: ResourceWorkspaceFile(),
+		State: map[string]interface{}{
+			"content_base64": "YWJjCg==",
+			"path":           "/lib/utils.py",
+		},
+		ID:          "/lib/utils.py",
+		RequiresNew: true,
+		Update:      true,
+	}.ApplyNoError(t)
+}
+
+func TestResourceWorkspaceFileDelete(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPost,
+				Resource: "/api/2.0/workspace/delete",
+				ExpectedRequest: NotebookDeleteRequest{
+					Path: "/lib/utils.py",
+				},
+			},
+		},
+		Resource: ResourceWorkspaceFile(),
+		Delete:   true,
+		ID:       "/lib/utils.py",
+	}.ApplyNoError(t)
+}
@@ -0,0 +1,362 @@
+package workspace
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// syncRemotePath returns workspace path of a notebook, that has no extension
+func syncRemotePath(root, rel string) string {
+	return path.Join(root, strings.TrimSuffix(rel, path.Ext(rel)))
+}
+
+// syncFormat returns import format and language of local file or false, if it's not a notebook
+func syncFormat(rel string) (ExportFormat, string, bool) {
+	ext := strings.ToLower(path.Ext(rel))
+	if format, ok := formatExtMap[ext]; ok {
+		return format, "", true
+	}
+	if lang, ok := extMap[ext]; ok {
+		return Source, lang, true
+	}
+	return "", "", false
+}
+
+// scanSyncSource returns manifest of notebooks in local directory: checksums by relative paths.
+// Hidden files and directories, as well as files with unknown extensions, are skipped.
+func scanSyncSource(dir string) (map[string]string, error) {
+	manifest := map[string]string{}
+	remote := map[string]string{}
+	err := filepath.Walk(dir, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if p != dir && strings.HasPrefix(info.Name(), ".") {
+			if info.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if info.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		format, _, ok := syncFormat(rel)
+		if !ok {
+			log.Printf("[DEBUG] Skipping %s, as it's not a notebook", rel)
+			return nil
+		}
+		target := syncRemotePath("", rel)
+		if other, ok := remote[target]; ok {
+			return fmt.Errorf("%s and %s are both synced to %s", other, rel, target)
+		}
+		remote[target] = rel
+		content, err := readFileContent(p)
+		if err != nil {
+			return err
+		}
+		manifest[rel] = fmt.Sprintf("%x", md5.Sum(normalizeNotebook(content, format)))
+		return nil
+	})
+	return manifest, err
+}
+
+// forEachParallel calls fn for every item with at most parallelism concurrent calls and returns the first error
+func forEachParallel(items []string, parallelism int, fn func(string) error) error {
+	var wg sync.WaitGroup
+	var errMu sync.Mutex
+	var firstErr error
+	slots := make(chan struct{}, parallelism)
+	for _, item := range items {
+		slots <- struct{}{}
+		errMu.Lock()
+		failed := firstErr != nil
+		errMu.Unlock()
+		if failed {
+			<-slots
+			break
+		}
+		wg.Add(1)
+		go func(item string) {
+			defer func() {
+				<-slots
+				wg.Done()
+			}()
+			if err := fn(item); err != nil {
+				errMu.Lock()
+				if firstErr == nil {
+					firstErr = err
+				}
+				errMu.Unlock()
+			}
+		}(item)
+	}
+	wg.Wait()
+	return firstErr
+}
+
+// workspaceSync mirrors notebooks from local directory into workspace path
+type workspaceSync struct {
+	api         NotebooksAPI
+	sourceDir   string
+	root        string
+	parallelism int
+}
+
+func newWorkspaceSync(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) workspaceSync {
+	return workspaceSync{
+		api:         NewNotebooksAPI(ctx, c),
+		sourceDir:   d.Get("source_dir").(string),
+		root:        d.Get("path").(string),
+		parallelism: d.Get("parallelism").(int),
+	}
+}
+
+func (ws workspaceSync) upload(rel string) error {
+	content, err := readFileContent(filepath.Join(ws.sourceDir, filepath.FromSlash(rel)))
+	if err != nil {
+		return err
+	}
+	format, lang, _ := syncFormat(rel)
+	remotePath := syncRemotePath(ws.root, rel)
+	if format == DBC {
+		// DBC archives cannot overwrite existing objects
+		if err = ws.remove(rel); err != nil {
+			return err
+		}
+	}
+	log.Printf("[INFO] Uploading %s to %s", rel, remotePath)
+	return ws.api.Create(ImportRequest{
+		Content:   base64.StdEncoding.EncodeToString(content),
+		Path:      remotePath,
+		Language:  lang,
+		Format:    string(format),
+		Overwrite: format != DBC,
+	})
+}
+
+func (ws workspaceSync) remove(rel string) error {
+	log.Printf("[INFO] Removing %s", syncRemotePath(ws.root, rel))
+	err := ws.api.Delete(syncRemotePath(ws.root, rel), false)
+	if common.IsMissing(err) {
+		return nil
+	}
+	return err
+}
+
+// apply uploads new and changed notebooks, then removes the ones, that are no longer present locally.
+// It returns the manifest of what is actually synced, even if some of the operations have failed:
+// failed uploads keep their previous checksums and failed removals stay in the manifest.
+func (ws workspaceSync) apply(previous, current map[string]string) (map[string]string, error) {
+	var mu sync.Mutex
+	synced := map[string]string{}
+	for rel, hash := range previous {
+		synced[rel] = hash
+	}
+	changed := []string{}
+	for rel, hash := range current {
+		if previous[rel] != hash {
+			changed = append(changed, rel)
+		}
+	}
+	removed := []string{}
+	for rel := range previous {
+		if _, ok := current[rel]; !ok {
+			removed = append(removed, rel)
+		}
+	}
+	sort.Strings(changed)
+	sort.Strings(removed)
+	dirs := map[string]bool{}
+	for _, rel := range changed {
+		dirs[path.Dir(syncRemotePath(ws.root, rel))] = true
+	}
+	parents := []string{}
+	for dir := range dirs {
+		parents = append(parents, dir)
+	}
+	sort.Strings(parents)
+	for _, dir := range parents {
+		if err := ws.api.Mkdirs(dir); err != nil {
+			return synced, err
+		}
+	}
+	err := forEachParallel(changed, ws.parallelism, func(rel string) error {
+		if err := ws.upload(rel); err != nil {
+			return err
+		}
+		mu.Lock()
+		synced[rel] = current[rel]
+		mu.Unlock()
+		return nil
+	})
+	if err != nil {
+		return synced, err
+	}
+	err = forEachParallel(removed, ws.parallelism, func(rel string) error {
+		if err := ws.remove(rel); err != nil {
+			return err
+		}
+		mu.Lock()
+		delete(synced, rel)
+		mu.Unlock()
+		return nil
+	})
+	return synced, err
+}
+
+func syncManifest(d *schema.ResourceData) map[string]string {
+	manifest := map[string]string{}
+	for k, v := range d.Get("files").(map[string]interface{}) {
+		manifest[k] = v.(string)
+	}
+	return manifest
+}
+
+// ResourceWorkspaceSync mirrors local directory with notebooks into workspace
+func ResourceWorkspaceSync() *schema.Resource {
+	s := map[string]*schema.Schema{
+		"path": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"source_dir": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"parallelism": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      4,
+			ValidateFunc: validation.IntBetween(1, 16),
+		},
+		"delete_recursive": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+		"files": {
+			Type:     schema.TypeMap,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+	}
+	return common.Resource{
+		Schema: s,
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, c interface{}) error {
+			if !d.NewValueKnown("source_dir") {
+				// directory is scanned during apply, once it's known
+				return d.SetNewComputed("files")
+			}
+			sourceDir := d.Get("source_dir").(string)
+			if sourceDir == "" {
+				// resource is being destroyed
+				return nil
+			}
+			manifest, err := scanSyncSource(sourceDir)
+			if err != nil {
+				return err
+			}
+			previous := d.Get("files").(map[string]interface{})
+			if len(previous) == len(manifest) {
+				same := true
+				for k, v := range manifest {
+					if previous[k] != v {
+						same = false
+						break
+					}
+				}
+				if same {
+					return nil
+				}
+			}
+			return d.SetNew("files", manifest)
+		},
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			ws := newWorkspaceSync(ctx, d, c)
+			manifest, err := scanSyncSource(ws.sourceDir)
+			if err != nil {
+				return err
+			}
+			if err = ws.api.Mkdirs(ws.root); err != nil {
+				return err
+			}
+			// uploaded notebooks are tracked in state, even if some of the uploads fail
+			d.SetId(ws.root)
+			synced, err := ws.apply(map[string]string{}, manifest)
+			if setErr := d.Set("files", synced); setErr != nil {
+				return setErr
+			}
+			return err
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			remote, err := NewNotebooksAPI(ctx, c).List(d.Id(), true)
+			if err != nil {
+				return err
+			}
+			present := map[string]bool{}
+			for _, v := range remote {
+				present[v.Path] = true
+			}
+			manifest := syncManifest(d)
+			for rel := range manifest {
+				if !present[syncRemotePath(d.Id(), rel)] {
+					// notebook is going to be uploaded again
+					log.Printf("[INFO] %s was removed from workspace", syncRemotePath(d.Id(), rel))
+					delete(manifest, rel)
+				}
+			}
+			d.Set("path", d.Id())
+			return d.Set("files", manifest)
+		},
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			ws := newWorkspaceSync(ctx, d, c)
+			manifest, err := scanSyncSource(ws.sourceDir)
+			if err != nil {
+				return err
+			}
+			old, _ := d.GetChange("files")
+			previous := map[string]string{}
+			for k, v := range old.(map[string]interface{}) {
+				previous[k] = v.(string)
+			}
+			synced, err := ws.apply(previous, manifest)
+			if setErr := d.Set("files", synced); setErr != nil {
+				return setErr
+			}
+			return err
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			ws := newWorkspaceSync(ctx, d, c)
+			ws.root = d.Id()
+			if d.Get("delete_recursive").(bool) {
+				return ws.api.Delete(ws.root, true)
+			}
+			managed := []string{}
+			for rel := range syncManifest(d) {
+				managed = append(managed, rel)
+			}
+			sort.Strings(managed)
+			return forEachParallel(managed, ws.parallelism, ws.remove)
+		},
+	}.ToResource()
+}
@@ -0,0 +1,483 @@
+package workspace
+
+import (
+	"context"
+	"crypto/md5"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+	"github.com/databrickslabs/terraform-provider-databricks/qa"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func syncSourceDir(t *testing.T, files map[string]string) string {
+	dir := t.TempDir()
+	for name, content := range files {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(p), 0755))
+		require.NoError(t, ioutil.WriteFile(p, []byte(content), 0644))
+	}
+	return dir
+}
+
+func md5Hex(content string) string {
+	return fmt.Sprintf("%x", md5.Sum([]byte(content)))
+}
+
+func TestScanSyncSource(t *testing.T) {
+	dir := syncSourceDir(t, map[string]string{
+		"a.py":              "print(1)\r\n",
+		"nested/b.sql":      "SELECT 1",
+		"README.md":         "skipped",
+		".git/config":       "skipped",
+		"nested/.hidden.py": "skipped",
+	})
+	manifest, err := scanSyncSource(dir)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"a.py":         md5Hex("print(1)\n"),
+		"nested/b.sql": md5Hex("SELECT 1"),
+	}, manifest)
+
+	dir = syncSourceDir(t, map[string]string{
+		"a.py":  "print(1)",
+		"a.sql": "SELECT 1",
+	})
+	_, err = scanSyncSource(dir)
+	assert.EqualError(t, err, "a.py and a.sql are both synced to a")
+
+	_, err = scanSyncSource(filepath.Join(dir, "missing"))
+	assert.Error(t, err)
+}
+
+func TestForEachParallel(t *testing.T) {
+	items := []string{}
+	for i := 0; i < 50; i++ {
+		items = append(items, fmt.Sprint(i))
+	}
+	var mu sync.Mutex
+	running, maxRunning, calls := 0, 0, 0
+	err := forEachParallel(items, 3, func(string) error {
+		mu.Lock()
+		running++
+		calls++
+		if running > maxRunning {
+			maxRunning = running
+		}
+		mu.Unlock()
+		mu.Lock()
+		running--
+		mu.Unlock()
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 50, calls)
+	assert.LessOrEqual(t, maxRunning, 3)
+
+	err = forEachParallel(items, 1, func(item string) error {
+		if item == "3" {
+			return fmt.Errorf("failed %s", item)
+		}
+		return nil
+	})
+	assert.EqualError(t, err, "failed 3")
+}
+
+func TestResourceWorkspaceSyncCreate(t *testing.T) {
+	dir := syncSourceDir(t, map[string]string{
+		"a.py":         "print(1)",
+		"nested/b.sql": "SELECT 1",
+	})
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/workspace/mkdirs",
+				ExpectedRequest: map[string]string{
+					"path": "/Shared/project",
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/workspace/mkdirs",
+				ExpectedRequest: map[string]string{
+					"path": "/Shared/project",
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/workspace/mkdirs",
+				ExpectedRequest: map[string]string{
+					"path": "/Shared/project/nested",
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/workspace/import",
+				ExpectedRequest: ImportRequest{
+					Content:   "cHJpbnQoMSk=",
+					Path:      "/Shared/project/a",
+					Language:  "PYTHON",
+					Format:    "SOURCE",
+					Overwrite: true,
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/workspace/import",
+				ExpectedRequest: ImportRequest{
+					Content:   "U0VMRUNUIDE=",
+					Path:      "/Shared/project/nested/b",
+					Language:  "SQL",
+					Format:    "SOURCE",
+					Overwrite: true,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/workspace/list?path=%2FShared%2Fproject",
+				Response: objectList{
+					Objects: []ObjectStatus{
+						{Path: "/Shared/project/a", ObjectType: Notebook},
+						{Path: "/Shared/project/nested", ObjectType: Directory},
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/workspace/list?path=%2FShared%2Fproject%2Fnested",
+				Response: objectList{
+					Objects: []ObjectStatus{
+						{Path: "/Shared/project/nested/b", ObjectType: Notebook},
+					},
+				},
+			},
+		},
+		Resource: ResourceWorkspaceSync(),
+		Create:   true,
+		HCL: fmt.Sprintf(`
+		path = "/Shared/project"
+		source_dir = "%s"
+		parallelism = 1`, filepath.ToSlash(dir)),
+	}.Apply(t)
+	require.NoError(t, err)
+	assert.Equal(t, "/Shared/project", d.Id())
+	assert.Equal(t, map[string]interface{}{
+		"a.py":         md5Hex("print(1)"),
+		"nested/b.sql": md5Hex("SELECT 1"),
+	}, d.Get("files"))
+}
+
+func TestResourceWorkspaceSyncUpdate(t *testing.T) {
+	dir := syncSourceDir(t, map[string]string{
+		"a.py":           "print(2)",
+		"nested/b.sql":   "SELECT 1",
+		"Notebook.ipynb": `{"cells":[]}`,
+	})
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/workspace/mkdirs",
+				ExpectedRequest: map[string]string{
+					"path": "/Shared/project",
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/workspace/import",
+				ExpectedRequest: ImportRequest{
+					Content:   "eyJjZWxscyI6W119",
+					Path:      "/Shared/project/Notebook",
+					Format:    "JUPYTER",
+					Overwrite: true,
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/workspace/import",
+				ExpectedRequest: ImportRequest{
+					Content:   "cHJpbnQoMik=",
+					Path:      "/Shared/project/a",
+					Language:  "PYTHON",
+					Format:    "SOURCE",
+					Overwrite: true,
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/workspace/delete",
+				ExpectedRequest: NotebookDeleteRequest{
+					Path: "/Shared/project/c",
+				},
+				Status: 404,
+				Response: common.APIErrorBody{
+					ErrorCode: "RESOURCE_DOES_NOT_EXIST",
+					Message:   "Path (/Shared/project/c) doesn't exist.",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/workspace/list?path=%2FShared%2Fproject",
+				Response: objectList{
+					Objects: []ObjectStatus{
+						{Path: "/Shared/project/a", ObjectType: Notebook},
+						{Path: "/Shared/project/Notebook", ObjectType: Notebook},
+						{Path: "/Shared/project/nested", ObjectType: Directory},
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/workspace/list?path=%2FShared%2Fproject%2Fnested",
+				Response: objectList{
+					Objects: []ObjectStatus{
+						{Path: "/Shared/project/nested/b", ObjectType: Notebook},
+					},
+				},
+			},
+		},
+		Resource: ResourceWorkspaceSync(),
+		Update:   true,
+		ID:       "/Shared/project",
+		InstanceState: map[string]string{
+			"path":               "/Shared/project",
+			"source_dir":         filepath.ToSlash(dir),
+			"parallelism":        "1",
+			"files.%":            "3",
+			"files.a.py":         md5Hex("print(1)"),
+			"files.nested/b.sql": md5Hex("SELECT 1"),
+			"files.c.scala":      md5Hex("println(1)"),
+		},
+		HCL: fmt.Sprintf(`
+		path = "/Shared/project"
+		source_dir = "%s"
+		parallelism = 1`, filepath.ToSlash(dir)),
+	}.Apply(t)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"a.py":           md5Hex("print(2)"),
+		"nested/b.sql":   md5Hex("SELECT 1"),
+		"Notebook.ipynb": md5Hex(`{"cells":[]}`),
+	}, d.Get("files"))
+}
+
+func TestResourceWorkspaceSyncDiff_SourceDirNotYetKnown(t *testing.T) {
+	r := ResourceWorkspaceSync()
+	state := &terraform.InstanceState{
+		ID: "/Shared/app",
+		Attributes: map[string]string{
+			"path":             "/Shared/app",
+			"source_dir":       "/tmp/app",
+			"parallelism":      "4",
+			"delete_recursive": "false",
+			"files.%":          "1",
+			"files.main.py":    md5Hex("print(1)"),
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"path": "/Shared/app",
+		// interpolated from a resource, that is not yet created
+		"source_dir": "74D93920-ED26-11E3-AC10-0800200C9A66",
+	})
+	diff, err := r.Diff(context.Background(), state, config, nil)
+	require.NoError(t, err)
+	assert.True(t, diff.Attributes["files.%"].NewComputed)
+}
+
+func TestResourceWorkspaceSyncRead_RemovedOnWorkspace(t *testing.T) {
+	dir := syncSourceDir(t, map[string]string{
+		"a.py":  "print(1)",
+		"b.sql": "SELECT 1",
+	})
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/workspace/list?path=%2FShared%2Fproject",
+				Response: objectList{
+					Objects: []ObjectStatus{
+						{Path: "/Shared/project/a", ObjectType: Notebook},
+					},
+				},
+			},
+		},
+		Resource: ResourceWorkspaceSync(),
+		Read:     true,
+		New:      true,
+		ID:       "/Shared/project",
+		HCL: fmt.Sprintf(`
+		path = "/Shared/project"
+		source_dir = "%s"`, filepath.ToSlash(dir)),
+		InstanceState: map[string]string{
+			"path":        "/Shared/project",
+			"files.%":     "2",
+			"files.a.py":  md5Hex("print(1)"),
+			"files.b.sql": md5Hex("SELECT 1"),
+		},
+	}.Apply(t)
+	require.NoError(t, err)
+	assert.Equal(t, "/Shared/project", d.Get("path"))
+	assert.Equal(t, map[string]interface{}{
+		"a.py": md5Hex("print(1)"),
+	}, d.Get("files"))
+}
+
+func TestResourceWorkspaceSyncRead_NotFound(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/workspace/list?path=%2FShared%2Fproject",
+				Status:   404,
+				Response: common.APIErrorBody{
+					ErrorCode: "RESOURCE_DOES_NOT_EXIST",
+					Message:   "Path (/Shared/project) doesn't exist.",
+				},
+			},
+		},
+		Resource: ResourceWorkspaceSync(),
+		Read:     true,
+		Removed:  true,
+		ID:       "/Shared/project",
+	}.ApplyNoError(t)
+}
+
+func TestResourceWorkspaceSyncDelete(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/workspace/delete",
+				ExpectedRequest: NotebookDeleteRequest{
+					Path: "/Shared/project/a",
+				},
+			},
+		},
+		Resource: ResourceWorkspaceSync(),
+		Delete:   true,
+		ID:       "/Shared/project",
+		InstanceState: map[string]string{
+			"parallelism": "1",
+			"files.%":     "1",
+			"files.a.py":  "abc",
+		},
+	}.ApplyNoError(t)
+}
+
+func TestResourceWorkspaceSyncDelete_Recursive(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/workspace/delete",
+				ExpectedRequest: NotebookDeleteRequest{
+					Path:      "/Shared/project",
+					Recursive: true,
+				},
+			},
+		},
+		Resource: ResourceWorkspaceSync(),
+		Delete:   true,
+		ID:       "/Shared/project",
+		InstanceState: map[string]string{
+			"delete_recursive": "true",
+		},
+	}.ApplyNoError(t)
+}
+
+func TestWorkspaceSyncApply_KeepsPreviousOnFailure(t *testing.T) {
+	dir := syncSourceDir(t, map[string]string{
+		"a.py": "print(2)",
+		"b.py": "print(3)",
+	})
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "POST",
+			Resource: "/api/2.0/workspace/mkdirs",
+			ExpectedRequest: map[string]string{
+				"path": "/Shared/project",
+			},
+		},
+		{
+			Method:   "POST",
+			Resource: "/api/2.0/workspace/import",
+			Status:   500,
+			Response: common.APIErrorBody{
+				ErrorCode: "INTERNAL_ERROR",
+				Message:   "nope",
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		ws := workspaceSync{
+			api:         NewNotebooksAPI(ctx, client),
+			sourceDir:   dir,
+			root:        "/Shared/project",
+			parallelism: 1,
+		}
+		synced, err := ws.apply(map[string]string{
+			"a.py": md5Hex("print(1)"),
+		}, map[string]string{
+			"a.py": md5Hex("print(2)"),
+			"b.py": md5Hex("print(3)"),
+		})
+		assert.EqualError(t, err, "nope")
+		assert.Equal(t, map[string]string{
+			"a.py": md5Hex("print(1)"),
+		}, synced)
+	})
+}
+
+func TestWorkspaceSyncApply_ReplacesDBC(t *testing.T) {
+	dir := syncSourceDir(t, map[string]string{
+		"archive.dbc": "abc",
+	})
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "POST",
+			Resource: "/api/2.0/workspace/mkdirs",
+			ExpectedRequest: map[string]string{
+				"path": "/Shared/project",
+			},
+		},
+		{
+			Method:   "POST",
+			Resource: "/api/2.0/workspace/delete",
+			ExpectedRequest: NotebookDeleteRequest{
+				Path: "/Shared/project/archive",
+			},
+		},
+		{
+			Method:   "POST",
+			Resource: "/api/2.0/workspace/import",
+			ExpectedRequest: ImportRequest{
+				Content: "YWJj",
+				Path:    "/Shared/project/archive",
+				Format:  "DBC",
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		ws := workspaceSync{
+			api:         NewNotebooksAPI(ctx, client),
+			sourceDir:   dir,
+			root:        "/Shared/project",
+			parallelism: 1,
+		}
+		synced, err := ws.apply(map[string]string{
+			"archive.dbc": md5Hex("xyz"),
+		}, map[string]string{
+			"archive.dbc": md5Hex("abc"),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{
+			"archive.dbc": md5Hex("abc"),
+		}, synced)
+	})
+}